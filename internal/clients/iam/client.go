@@ -1,7 +1,7 @@
 package iam
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,7 +12,8 @@ import (
 )
 
 type Client struct {
-	client *rest.Client
+	client      *rest.Client
+	permissions *permissionCatalog
 }
 
 func NewClient(url string, timeout time.Duration) *Client {
@@ -25,7 +26,8 @@ func NewClient(url string, timeout time.Duration) *Client {
 		url = url[:len(url)-4]
 	}
 	return &Client{
-		rest.NewClient(url).WithTimeout(timeout),
+		client:      rest.NewClient(url).WithTimeout(timeout),
+		permissions: &permissionCatalog{},
 	}
 }
 
@@ -44,14 +46,34 @@ func (c *Client) WithBearerToken(token string) *Client {
 	return c
 }
 
+func (c *Client) WithTokenSource(tokenSource rest.TokenSource) *Client {
+	c.client.WithTokenSource(tokenSource)
+	return c
+}
+
+func (c *Client) WithRetry(maxAttempts int, baseDelay time.Duration, maxDelay time.Duration) *Client {
+	c.client.WithRetry(maxAttempts, baseDelay, maxDelay)
+	return c
+}
+
+func (c *Client) WithRateLimit(requestsPerSecond float64, burst int) *Client {
+	c.client.WithRateLimit(requestsPerSecond, burst)
+	return c
+}
+
+func (c *Client) WithMaxConcurrentRequests(maxConcurrentRequests int) *Client {
+	c.client.WithMaxConcurrentRequests(maxConcurrentRequests)
+	return c
+}
+
 func (c *Client) WithServiceAccountToken(token string) *Client {
 	c.client.AddDefaultHeader("X-S11-CREDENTIAL", token)
 	return c
 }
 
-func (c Client) Health() error {
+func (c Client) Health(ctx context.Context) error {
 	// check for availability and auth by using
-	resp, err := c.client.NewRequest(http.MethodGet, "/").Do()
+	resp, err := c.client.NewRequest(http.MethodGet, "/").Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -65,34 +87,11 @@ func (c Client) Health() error {
 	return nil
 }
 
-var errorResponse struct {
-	Errors []struct {
-		Field   string `json:"field"`
-		Message string `json:"message"`
-	} `json:"errors"`
-}
-
 func (c *Client) checkResponse(response *rest.Response) error {
-	genericError := func(message string) error {
-		errorMsg := fmt.Sprintf(
-			"unexpected response from iam service: HTTP %d - %s for %s %s",
-			response.StatusCode,
-			message,
-			response.Request.Method,
-			response.Request.URL,
-		)
-
-		logging.Error(errorMsg)
-		return errors.New(errorMsg)
-	}
-
 	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		buf, err := response.StringBody()
-		if err != nil {
-			return genericError(err.Error())
-		}
-
-		return genericError(buf)
+		apiError := newAPIError(response)
+		logging.Error(apiError.Error())
+		return apiError
 	}
 	return nil
 }
@@ -1,66 +1,208 @@
 package iam
 
-const GetOrganizationsError string = "could not get organization: %s"
-const CreateOrganizationError string = "could not create organization: %s"
-const UpdateOrganizationError string = "could not update organization: %s"
-const DeleteOrganizationError string = "could not delete organization: %s"
-
-const GetProjectError string = "could not get project: %s"
-const CreateProjectError string = "could not create project: %s"
-const UpdateProjectError string = "could not update project: %s"
-const DeleteProjectError string = "could not delete project: %s"
-
-const GetOrganizationMembershipError string = "could not get OrganizationMembership: %s"
-const CreateOrganizationMembershipError string = "could not create OrganizationMembership: %s %s"
-const UpdateOrganizationMembershipError string = "could not update OrganizationMembership: %s"
-const DeleteOrganizationMembershipError string = "could not delete OrganizationMembership: %s"
-
-const GetOrganizationInvitationError string = "could not get OrganizationInvitation: %s"
-const CreateOrganizationInvitationError string = "could not create OrganizationInvitation: %s for %s"
-
-const GetProjectMembershipError string = "could not get ProjectMembership: %s"
-const CreateProjectMembershipError string = "could not create ProjectMembership: %s"
-const UpdateProjectMembershipError string = "could not update ProjectMembership: %s"
-const DeleteProjectMembershipError string = "could not delete ProjectMembership: %s"
-
-const GetOrganizationServiceaccountError string = "could not get OrganizationServiceaccount: %s"
-const CreateOrganizationServiceaccountError string = "could not create OrganizationServiceaccount: %s"
-const UpdateOrganizationServiceaccountError string = "could not update OrganizationServiceaccount: %s"
-const DeleteOrganizationServiceaccountError string = "could not delete OrganizationServiceaccount: %s"
-
-const CreateOrganizationServiceaccountPermissionError string = "could not create OrganizationServiceaccountPermission: %s"
-const UpdateOrganizationServiceaccountPermissionError string = "could not update OrganizationServiceaccountPermission: %s"
-
-const GetOrganizationTeamError string = "could not get OrganizationTeam: %s"
-const CreateOrganizationTeamError string = "could not create OrganizationTeam: %s %s"
-const UpdateOrganizationTeamError string = "could not update OrganizationTeam: %s"
-const DeleteOrganizationTeamError string = "could not delete OrganizationTeam: %s"
-
-const GetOrganizationContactError string = "could not get OrganizationContact: %s"
-const CreateOrganizationContactError string = "could not create OrganizationContact: %s %s"
-const UpdateOrganizationContactError string = "could not update OrganizationContact: %s"
-const DeleteOrganizationContactError string = "could not delete OrganizationContact: %s"
-
-const GetProjectTeamPermissionsError string = "could not get ProjectTeamPermissions: %s"
-const CreateProjectTeamPermissionsError string = "could not create ProjectTeamPermissions: %s %s"
-const UpdateProjectTeamPermissionsError string = "could not update ProjectTeamPermissions: %s"
-const DeleteProjectTeamPermissionsError string = "could not delete ProjectTeamPermissions: %s"
-
-const GetOrganizationTeamMembershipError string = "could not get OrganizationTeamMembership: %s"
-const CreateOrganizationTeamMembershipError string = "could not create OrganizationTeamMembership: %s %s"
-const UpdateOrganizationTeamMembershipError string = "could not update OrganizationTeamMembership: %s"
-const DeleteOrganizationTeamMembershipError string = "could not delete OrganizationTeamMembership: %s"
-
-const GetProjectTeamMembershipError string = "could not get ProjectTeamMembership: %s"
-const CreateProjectTeamMembershipError string = "could not create ProjectTeamMembership: %s %s"
-const UpdateProjectTeamMembershipError string = "could not update ProjectTeamMembership: %s"
-const DeleteProjectTeamMembershipError string = "could not delete ProjectTeamMembership: %s"
-
-const GetProjectS3UserError string = "could not get ProjectS3User: %s"
-const CreateProjectS3UserError string = "could not create ProjectS3User: %s %s"
-const UpdateProjectS3UserError string = "could not update ProjectS3User: %s"
-const DeleteProjectS3UserError string = "could not delete ProjectS3User: %s"
-
-const GetProjectS3UserKeyError string = "could not get ProjectS3UserKey: %s"
-const CreateProjectS3UserKeyError string = "could not create ProjectS3UserKey: %s %s"
-const DeleteProjectS3UserKeyError string = "could not delete ProjectS3UserKey: %s"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/rest"
+)
+
+// FieldError is reported by the iam service for a single invalid field of a
+// request payload
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// APIError is returned for every response of the iam service with a status
+// code outside of the 2xx range
+type APIError struct {
+	StatusCode  int
+	Method      string
+	URL         string
+	RequestID   string
+	Message     string
+	FieldErrors []FieldError
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf(
+		"unexpected response from iam service: HTTP %d - %s for %s %s",
+		e.StatusCode,
+		e.Message,
+		e.Method,
+		e.URL,
+	)
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request id: %s)", msg, e.RequestID)
+	}
+	return msg
+}
+
+func newAPIError(response *rest.Response) *APIError {
+	apiError := &APIError{
+		StatusCode: response.StatusCode,
+		Method:     response.Request.Method,
+		URL:        response.Request.URL.String(),
+		RequestID:  response.Header.Get(rest.RequestIDHeader),
+	}
+	if apiError.RequestID == "" {
+		apiError.RequestID = response.Request.Header.Get(rest.RequestIDHeader)
+	}
+
+	body, err := response.ByteBody()
+	if err != nil {
+		apiError.Message = err.Error()
+		return apiError
+	}
+	apiError.Message = strings.TrimSpace(string(body))
+
+	var errorResponse struct {
+		Errors []FieldError `json:"errors"`
+	}
+	if json.Unmarshal(body, &errorResponse) == nil {
+		for _, fieldError := range errorResponse.Errors {
+			if fieldError.Field != "" || fieldError.Message != "" {
+				apiError.FieldErrors = append(apiError.FieldErrors, fieldError)
+			}
+		}
+	}
+	return apiError
+}
+
+// NotFoundError is returned by lookups like GetOrganizationByName when no
+// object matches
+type NotFoundError struct {
+	Kind  string
+	Field string
+	Value string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s with %s %q was not found", e.Kind, e.Field, e.Value)
+}
+
+// MultipleMatchesError is returned by lookups when more than one object
+// matches, as picking one of them could silently manage the wrong object
+type MultipleMatchesError struct {
+	Kind    string
+	Field   string
+	Value   string
+	Matches int
+}
+
+func (e *MultipleMatchesError) Error() string {
+	return fmt.Sprintf("%d objects of kind %s with %s %q were found, expected exactly one", e.Matches, e.Kind, e.Field, e.Value)
+}
+
+func IsMultipleMatches(err error) bool {
+	var multipleMatchesError *MultipleMatchesError
+	return errors.As(err, &multipleMatchesError)
+}
+
+// IsStatus reports whether err is or wraps an APIError with the given status code
+func IsStatus(err error, statusCode int) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == statusCode
+}
+
+// IsNotFound reports whether the iam service answered with 404 or a lookup
+// did not find a matching object
+func IsNotFound(err error) bool {
+	var notFoundError *NotFoundError
+	return IsStatus(err, http.StatusNotFound) || errors.As(err, &notFoundError)
+}
+
+// IsNoMatch reports whether a lookup succeeded but did not find a matching
+// object. Unlike IsNotFound it does not match a 404 of the list endpoint.
+func IsNoMatch(err error) bool {
+	var notFoundError *NotFoundError
+	return errors.As(err, &notFoundError)
+}
+
+func IsConflict(err error) bool {
+	return IsStatus(err, http.StatusConflict)
+}
+
+func IsForbidden(err error) bool {
+	return IsStatus(err, http.StatusForbidden)
+}
+
+const GetOrganizationsError string = "could not get organization: %w"
+const CreateOrganizationError string = "could not create organization: %w"
+const UpdateOrganizationError string = "could not update organization: %w"
+const DeleteOrganizationError string = "could not delete organization: %w"
+
+const GetProjectError string = "could not get project: %w"
+const GetProjectsError string = "could not get projects: %w"
+const CreateProjectError string = "could not create project: %w"
+const UpdateProjectError string = "could not update project: %w"
+const DeleteProjectError string = "could not delete project: %w"
+
+const GetOrganizationMembershipError string = "could not get OrganizationMembership: %w"
+const CreateOrganizationMembershipError string = "could not create OrganizationMembership: %w %s"
+const UpdateOrganizationMembershipError string = "could not update OrganizationMembership: %w"
+const DeleteOrganizationMembershipError string = "could not delete OrganizationMembership: %w"
+
+const GetOrganizationInvitationError string = "could not get OrganizationInvitation: %w"
+const CreateOrganizationInvitationError string = "could not create OrganizationInvitation: %w for %s"
+const DeleteOrganizationInvitationError string = "could not delete OrganizationInvitation: %w"
+
+const GetProjectMembershipError string = "could not get ProjectMembership: %w"
+const CreateProjectMembershipError string = "could not create ProjectMembership: %w"
+const UpdateProjectMembershipError string = "could not update ProjectMembership: %w"
+const DeleteProjectMembershipError string = "could not delete ProjectMembership: %w"
+
+const GetOrganizationServiceaccountError string = "could not get OrganizationServiceaccount: %w"
+const CreateOrganizationServiceaccountError string = "could not create OrganizationServiceaccount: %w"
+const UpdateOrganizationServiceaccountError string = "could not update OrganizationServiceaccount: %w"
+const DeleteOrganizationServiceaccountError string = "could not delete OrganizationServiceaccount: %w"
+
+const GetOrganizationServiceaccountPermissionError string = "could not get OrganizationServiceaccountPermission: %w"
+const CreateOrganizationServiceaccountPermissionError string = "could not create OrganizationServiceaccountPermission: %w"
+const UpdateOrganizationServiceaccountPermissionError string = "could not update OrganizationServiceaccountPermission: %w"
+
+const GetOrganizationServiceaccountSecretError string = "could not get OrganizationServiceaccountSecret: %w"
+const CreateOrganizationServiceaccountSecretError string = "could not create OrganizationServiceaccountSecret: %w"
+const DeleteOrganizationServiceaccountSecretError string = "could not delete OrganizationServiceaccountSecret: %w"
+
+const GetOrganizationTeamError string = "could not get OrganizationTeam: %w"
+const CreateOrganizationTeamError string = "could not create OrganizationTeam: %w %s"
+const UpdateOrganizationTeamError string = "could not update OrganizationTeam: %w"
+const DeleteOrganizationTeamError string = "could not delete OrganizationTeam: %w"
+
+const GetOrganizationContactError string = "could not get OrganizationContact: %w"
+const CreateOrganizationContactError string = "could not create OrganizationContact: %w %s"
+const UpdateOrganizationContactError string = "could not update OrganizationContact: %w"
+const DeleteOrganizationContactError string = "could not delete OrganizationContact: %w"
+
+const GetProjectTeamPermissionsError string = "could not get ProjectTeamPermissions: %w"
+const CreateProjectTeamPermissionsError string = "could not create ProjectTeamPermissions: %w %s"
+const UpdateProjectTeamPermissionsError string = "could not update ProjectTeamPermissions: %w"
+const DeleteProjectTeamPermissionsError string = "could not delete ProjectTeamPermissions: %w"
+
+const GetOrganizationTeamMembershipError string = "could not get OrganizationTeamMembership: %w"
+const CreateOrganizationTeamMembershipError string = "could not create OrganizationTeamMembership: %w %s"
+const UpdateOrganizationTeamMembershipError string = "could not update OrganizationTeamMembership: %w"
+const DeleteOrganizationTeamMembershipError string = "could not delete OrganizationTeamMembership: %w"
+
+const GetProjectTeamMembershipError string = "could not get ProjectTeamMembership: %w"
+const CreateProjectTeamMembershipError string = "could not create ProjectTeamMembership: %w %s"
+const UpdateProjectTeamMembershipError string = "could not update ProjectTeamMembership: %w"
+const DeleteProjectTeamMembershipError string = "could not delete ProjectTeamMembership: %w"
+
+const GetProjectS3UserError string = "could not get ProjectS3User: %w"
+const CreateProjectS3UserError string = "could not create ProjectS3User: %w %s"
+const UpdateProjectS3UserError string = "could not update ProjectS3User: %w"
+const DeleteProjectS3UserError string = "could not delete ProjectS3User: %w"
+
+const GetProjectS3UserKeyError string = "could not get ProjectS3UserKey: %w"
+const CreateProjectS3UserKeyError string = "could not create ProjectS3UserKey: %w %s"
+const DeleteProjectS3UserKeyError string = "could not delete ProjectS3UserKey: %w"
+
+const GetPermissionsError string = "could not get permissions: %w"
+
+const GetCurrentIdentityError string = "could not get current identity: %w"
@@ -1,9 +1,12 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/syseleven/terraform-provider-sys11iam/internal/errors"
 )
@@ -28,6 +31,8 @@ const IAMProjectMembershipPermissionsEndpoint string = "/v2/orgs/%s/projects/%s/
 const IAMOrganizationServiceaccountsEndpoint string = "/v2/orgs/%s/service-accounts"
 const IAMOrganizationServiceaccountEndpoint string = "/v2/orgs/%s/service-accounts/%s"
 const IAMOrganizationServiceaccountPermissionsEndpoint string = "/v2/orgs/%s/service-accounts/%s/permissions"
+const IAMOrganizationServiceaccountSecretsEndpoint string = "/v2/orgs/%s/service-accounts/%s/secrets"
+const IAMOrganizationServiceaccountSecretEndpoint string = "/v2/orgs/%s/service-accounts/%s/secrets/%s"
 
 const IAMOrganizationTeamsEndpoint string = "/v2/orgs/%s/teams"
 const IAMOrganizationTeamEndpoint string = "/v2/orgs/%s/teams/%s"
@@ -50,6 +55,8 @@ const IAMProjectS3UserEndpoint string = "/v2/orgs/%s/projects/%s/s3-users/%s"
 const IAMProjectS3UserKeysEndpoint string = "/v2/orgs/%s/projects/%s/s3-users/%s/ec2-credentials"
 const IAMProjectS3UserKeyEndpoint string = "/v2/orgs/%s/projects/%s/s3-users/%s/ec2-credentials/%s"
 
+const IAMCurrentIdentityEndpoint string = "/v2/me"
+
 type IAMOrganization struct {
 	// org id
 	ID string `json:"id" validate:"required"`
@@ -284,6 +291,24 @@ type IAMOrganizationInvitation struct {
 	CreatedAt string `json:"created_at"`
 }
 
+// invitationTimeLayouts are the formats the iam service uses for timestamps,
+// with and without time zone
+var invitationTimeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05.999999999", time.DateOnly}
+
+// ExpiresAt parses the expiration date of the invitation. Timestamps without
+// time zone are in UTC.
+func (i IAMOrganizationInvitation) ExpiresAt() (time.Time, error) {
+	var err error
+	for _, layout := range invitationTimeLayouts {
+		var expiresAt time.Time
+		expiresAt, err = time.Parse(layout, i.ExpirationDate)
+		if err == nil {
+			return expiresAt, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid expiration date %q of invitation %s: %w", i.ExpirationDate, i.ID, err)
+}
+
 type IAMProjectMembership struct {
 	// project id
 	ProjectId string `json:"project_id,omitempty"`
@@ -300,6 +325,44 @@ type IAMProjectMembership struct {
 	Project        IAMProject                    `json:"project"`
 }
 
+type IAMOrganizationServiceaccountSecret struct {
+	// secret id
+	ID string `json:"id" validate:"required"`
+
+	// the credential, only returned when the secret is created
+	Secret string `json:"secret,omitempty"`
+
+	CreatedAt string `json:"created_at"`
+}
+
+type IAMCurrentIdentity struct {
+	// identity type, either user or service_account
+	Type string `json:"type" validate:"required"`
+
+	// user or service account id
+	ID string `json:"id" validate:"required"`
+
+	// user or service account name
+	Name string `json:"name"`
+
+	// user email, empty for service accounts
+	Email string `json:"email"`
+
+	// organizations the identity is a member of
+	Organizations []IAMCurrentIdentityOrganization `json:"organizations"`
+}
+
+type IAMCurrentIdentityOrganization struct {
+	// org id
+	ID string `json:"id" validate:"required"`
+
+	// org name
+	Name string `json:"name"`
+
+	// permissions of the identity in the organization
+	Permissions []string `json:"permissions"`
+}
+
 type IAMOrganizationServiceaccount struct {
 	// OrganizationServiceaccount id
 	ID string `json:"id"`
@@ -317,43 +380,42 @@ type IAMOrganizationServiceaccount struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
-func (c *Client) GetOrganization(id string) (IAMOrganization, error) {
-	path := fmt.Sprintf(IAMOrganizationEndpoint, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+func (c *Client) GetCurrentIdentity(ctx context.Context) (IAMCurrentIdentity, error) {
+	response, err := c.client.NewRequest(http.MethodGet, IAMCurrentIdentityEndpoint).Do(ctx)
 	if err != nil {
-		return IAMOrganization{}, errors.Trace(fmt.Errorf(GetOrganizationsError, err.Error()))
+		return IAMCurrentIdentity{}, errors.Trace(fmt.Errorf(GetCurrentIdentityError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganization{}, errors.Trace(fmt.Errorf(GetOrganizationsError, err.Error()))
+		return IAMCurrentIdentity{}, errors.Trace(fmt.Errorf(GetCurrentIdentityError, err))
 	}
 
-	var iamOrganization IAMOrganization
-	err = response.JSONUnmarshall(&iamOrganization)
+	var iamCurrentIdentity IAMCurrentIdentity
+	err = response.JSONUnmarshall(&iamCurrentIdentity)
 	if err != nil {
 		body, respErr := response.StringBody()
 		if respErr != nil {
 			body = "unable to parse body"
 		}
-		return IAMOrganization{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
+		return IAMCurrentIdentity{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
 	}
 
-	return iamOrganization, nil
+	return iamCurrentIdentity, nil
 }
 
-func (c *Client) GetOrganizationByName(name string) (IAMOrganization, error) {
-	path := fmt.Sprintf(IAMOrganizationsEndpoint)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+func (c *Client) GetOrganization(ctx context.Context, id string) (IAMOrganization, error) {
+	path := fmt.Sprintf(IAMOrganizationEndpoint, id)
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganization{}, errors.Trace(fmt.Errorf(GetOrganizationsError, err.Error()))
+		return IAMOrganization{}, errors.Trace(fmt.Errorf(GetOrganizationsError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganization{}, errors.Trace(fmt.Errorf(GetOrganizationsError, err.Error()))
+		return IAMOrganization{}, errors.Trace(fmt.Errorf(GetOrganizationsError, err))
 	}
 
-	var iamOrganizations []IAMOrganization
-	err = response.JSONUnmarshall(&iamOrganizations)
+	var iamOrganization IAMOrganization
+	err = response.JSONUnmarshall(&iamOrganization)
 	if err != nil {
 		body, respErr := response.StringBody()
 		if respErr != nil {
@@ -362,16 +424,22 @@ func (c *Client) GetOrganizationByName(name string) (IAMOrganization, error) {
 		return IAMOrganization{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
 	}
 
-	for _, io := range iamOrganizations {
-		if io.Name == name {
-			return io, nil
-		}
-	}
+	return iamOrganization, nil
+}
+
+// ListOrganizations returns an iterator over all organizations visible to the caller
+func (c *Client) ListOrganizations(ctx context.Context, filters url.Values) *ListIterator[IAMOrganization] {
+	return newListIterator[IAMOrganization](c, IAMOrganizationsEndpoint, filters, GetOrganizationsError)
+}
 
-	return IAMOrganization{}, nil
+func (c *Client) GetOrganizationByName(ctx context.Context, name string) (IAMOrganization, error) {
+	organizations := c.ListOrganizations(ctx, url.Values{"name": {name}})
+	return findOne(ctx, organizations, "organization", "name", name, func(org IAMOrganization) bool {
+		return org.Name == name
+	})
 }
 
-func (c *Client) CreateOrganization(org IAMOrganization) (IAMOrganization, error) {
+func (c *Client) CreateOrganization(ctx context.Context, org IAMOrganization) (IAMOrganization, error) {
 	var iamOrganization IAMOrganization
 	path := IAMOrganizationsEndpoint
 	payload, err := json.Marshal(map[string]interface{}{
@@ -397,15 +465,14 @@ func (c *Client) CreateOrganization(org IAMOrganization) (IAMOrganization, error
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganization, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		err = fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, response.Body)
-		return iamOrganization, fmt.Errorf(CreateOrganizationError, err.Error())
+		return iamOrganization, fmt.Errorf(CreateOrganizationError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganization)
@@ -421,7 +488,7 @@ func (c *Client) CreateOrganization(org IAMOrganization) (IAMOrganization, error
 	return iamOrganization, nil
 }
 
-func (c *Client) UpdateOrganization(id string, org IAMOrganization) (IAMOrganization, error) {
+func (c *Client) UpdateOrganization(ctx context.Context, id string, org IAMOrganization) (IAMOrganization, error) {
 	var iamOrganization IAMOrganization
 	path := fmt.Sprintf(IAMOrganizationEndpoint, id)
 	payload, err := json.Marshal(map[string]interface{}{
@@ -434,14 +501,14 @@ func (c *Client) UpdateOrganization(id string, org IAMOrganization) (IAMOrganiza
 
 	response, err := c.client.NewRequest(http.MethodPut, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganization, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganization, fmt.Errorf(UpdateOrganizationError, err.Error())
+		return iamOrganization, fmt.Errorf(UpdateOrganizationError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganization)
@@ -456,10 +523,10 @@ func (c *Client) UpdateOrganization(id string, org IAMOrganization) (IAMOrganiza
 	return iamOrganization, nil
 }
 
-func (c *Client) DeleteOrganization(id string) error {
+func (c *Client) DeleteOrganization(ctx context.Context, id string) error {
 	path := fmt.Sprintf(IAMOrganizationEndpoint, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -468,20 +535,20 @@ func (c *Client) DeleteOrganization(id string) error {
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteOrganizationError, err.Error())
+		return fmt.Errorf(DeleteOrganizationError, err)
 	}
 	return nil
 }
 
-func (c *Client) GetProject(org_id string, id string) (IAMProject, error) {
+func (c *Client) GetProject(ctx context.Context, org_id string, id string) (IAMProject, error) {
 	path := fmt.Sprintf(IAMProjectEndpoint, org_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMProject{}, errors.Trace(fmt.Errorf(GetProjectError, err.Error()))
+		return IAMProject{}, errors.Trace(fmt.Errorf(GetProjectError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMProject{}, errors.Trace(fmt.Errorf(GetProjectError, err.Error()))
+		return IAMProject{}, errors.Trace(fmt.Errorf(GetProjectError, err))
 	}
 
 	var iamProject IAMProject
@@ -497,7 +564,19 @@ func (c *Client) GetProject(org_id string, id string) (IAMProject, error) {
 	return iamProject, nil
 }
 
-func (c *Client) CreateProject(org_id string, name string, description string, tags []string) (IAMProject, error) {
+func (c *Client) ListProjects(ctx context.Context, org_id string, filters url.Values) *ListIterator[IAMProject] {
+	path := fmt.Sprintf(IAMProjectsEndpoint, org_id)
+	return newListIterator[IAMProject](c, path, filters, GetProjectsError)
+}
+
+func (c *Client) GetProjectByName(ctx context.Context, org_id string, name string) (IAMProject, error) {
+	projects := c.ListProjects(ctx, org_id, url.Values{"name": {name}})
+	return findOne(ctx, projects, "project", "name", name, func(project IAMProject) bool {
+		return project.Name == name
+	})
+}
+
+func (c *Client) CreateProject(ctx context.Context, org_id string, name string, description string, tags []string) (IAMProject, error) {
 	var iamProject IAMProject
 	path := fmt.Sprintf(IAMProjectsEndpoint, org_id)
 	payload, err := json.Marshal(map[string]interface{}{
@@ -511,14 +590,14 @@ func (c *Client) CreateProject(org_id string, name string, description string, t
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProject, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProject, fmt.Errorf(CreateProjectError, err.Error())
+		return iamProject, fmt.Errorf(CreateProjectError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamProject)
@@ -533,7 +612,7 @@ func (c *Client) CreateProject(org_id string, name string, description string, t
 	return iamProject, nil
 }
 
-func (c *Client) UpdateProject(org_id string, id string, name string, description string, tags []string) (IAMProject, error) {
+func (c *Client) UpdateProject(ctx context.Context, org_id string, id string, name string, description string, tags []string) (IAMProject, error) {
 	var iamProject IAMProject
 	path := fmt.Sprintf(IAMProjectEndpoint, org_id, id)
 	payload, err := json.Marshal(map[string]interface{}{
@@ -547,14 +626,14 @@ func (c *Client) UpdateProject(org_id string, id string, name string, descriptio
 
 	response, err := c.client.NewRequest(http.MethodPut, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProject, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProject, fmt.Errorf(UpdateProjectError, err.Error())
+		return iamProject, fmt.Errorf(UpdateProjectError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamProject)
@@ -569,10 +648,10 @@ func (c *Client) UpdateProject(org_id string, id string, name string, descriptio
 	return iamProject, nil
 }
 
-func (c *Client) DeleteProject(org_id string, id string) error {
+func (c *Client) DeleteProject(ctx context.Context, org_id string, id string) error {
 	path := fmt.Sprintf(IAMProjectEndpoint, org_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -581,20 +660,20 @@ func (c *Client) DeleteProject(org_id string, id string) error {
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectError, err.Error())
+		return fmt.Errorf(DeleteProjectError, err)
 	}
 	return nil
 }
 
-func (c *Client) GetOrganizationMembership(org_id string, id string) (IAMOrganizationMembership, error) {
+func (c *Client) GetOrganizationMembership(ctx context.Context, org_id string, id string) (IAMOrganizationMembership, error) {
 	path := fmt.Sprintf(IAMOrganizationMembershipEndpoint, org_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf(GetOrganizationMembershipError, err.Error()))
+		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf(GetOrganizationMembershipError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf(GetOrganizationMembershipError, err.Error()))
+		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf(GetOrganizationMembershipError, err))
 	}
 
 	var iamOrganizationMembership IAMOrganizationMembership
@@ -610,38 +689,20 @@ func (c *Client) GetOrganizationMembership(org_id string, id string) (IAMOrganiz
 	return iamOrganizationMembership, nil
 }
 
-func (c *Client) GetOrganizationMembershipByEmail(org_id string, email string) (IAMOrganizationMembership, error) {
+func (c *Client) ListOrganizationMemberships(ctx context.Context, org_id string, filters url.Values) *ListIterator[IAMOrganizationMembership] {
 	path := fmt.Sprintf(IAMOrganizationMembershipsEndpoint, org_id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
-	if err != nil {
-		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf(GetOrganizationMembershipError, err.Error()))
-	}
-	err = c.checkResponse(response)
-	if err != nil {
-		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf(GetOrganizationMembershipError, err.Error()))
-	}
-
-	var iamOrganizationMemberships []IAMOrganizationMembership
-	err = response.JSONUnmarshall(&iamOrganizationMemberships)
-	if err != nil {
-		body, respErr := response.StringBody()
-		if respErr != nil {
-			body = "unable to parse body"
-		}
-		return IAMOrganizationMembership{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
-	}
-
-	for _, iom := range iamOrganizationMemberships {
-		if iom.User.Email == email {
-			return iom, nil
-		}
-	}
+	return newListIterator[IAMOrganizationMembership](c, path, filters, GetOrganizationMembershipError)
+}
 
-	return IAMOrganizationMembership{}, fmt.Errorf("membership with that e-mail address was not found: %s", email)
+func (c *Client) GetOrganizationMembershipByEmail(ctx context.Context, org_id string, email string) (IAMOrganizationMembership, error) {
+	memberships := c.ListOrganizationMemberships(ctx, org_id, url.Values{"email": {email}})
+	return findOne(ctx, memberships, "organization membership", "e-mail address", email, func(membership IAMOrganizationMembership) bool {
+		return membership.User.Email == email
+	})
 }
 
-func (c *Client) CreateOrganizationMembership(org_id string, user_id string, affiliation string, permissions []string) (IAMOrganizationMembership, error) {
-	iamOrganizationMembership, err := c.GetOrganizationMembership(org_id, user_id)
+func (c *Client) CreateOrganizationMembership(ctx context.Context, org_id string, user_id string, affiliation string, permissions []string) (IAMOrganizationMembership, error) {
+	iamOrganizationMembership, err := c.GetOrganizationMembership(ctx, org_id, user_id)
 	if err != nil {
 		return iamOrganizationMembership, err
 	}
@@ -659,14 +720,14 @@ func (c *Client) CreateOrganizationMembership(org_id string, user_id string, aff
 
 	response, err := c.client.NewRequest(http.MethodPatch, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationMembership, fmt.Errorf(CreateOrganizationMembershipError, err.Error(), path)
+		return iamOrganizationMembership, fmt.Errorf(CreateOrganizationMembershipError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationMembership)
@@ -681,8 +742,8 @@ func (c *Client) CreateOrganizationMembership(org_id string, user_id string, aff
 	return iamOrganizationMembership, nil
 }
 
-func (c *Client) UpdateOrganizationMembership(org_id string, user_id string, affiliation string, permissions []string) (IAMOrganizationMembership, error) {
-	iamOrganizationMembership, err := c.GetOrganizationMembership(org_id, user_id)
+func (c *Client) UpdateOrganizationMembership(ctx context.Context, org_id string, user_id string, affiliation string, permissions []string) (IAMOrganizationMembership, error) {
+	iamOrganizationMembership, err := c.GetOrganizationMembership(ctx, org_id, user_id)
 	if err != nil {
 		return iamOrganizationMembership, err
 	}
@@ -700,14 +761,14 @@ func (c *Client) UpdateOrganizationMembership(org_id string, user_id string, aff
 
 	response, err := c.client.NewRequest(http.MethodPatch, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationMembership, fmt.Errorf(UpdateOrganizationMembershipError, err.Error())
+		return iamOrganizationMembership, fmt.Errorf(UpdateOrganizationMembershipError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationMembership)
@@ -722,14 +783,14 @@ func (c *Client) UpdateOrganizationMembership(org_id string, user_id string, aff
 	return iamOrganizationMembership, nil
 }
 
-func (c *Client) DeleteOrganizationMembership(org_id string, id string) error {
-	err := c.DeleteOrganizationServiceaccount(org_id, id)
+func (c *Client) DeleteOrganizationMembership(ctx context.Context, org_id string, id string) error {
+	err := c.DeleteOrganizationServiceaccount(ctx, org_id, id)
 	if err == nil {
 		return nil
 	}
 	path := fmt.Sprintf(IAMOrganizationMembershipEndpoint, org_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -738,41 +799,24 @@ func (c *Client) DeleteOrganizationMembership(org_id string, id string) error {
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteOrganizationMembershipError, err.Error())
+		return fmt.Errorf(DeleteOrganizationMembershipError, err)
 	}
 	return nil
 }
 
-func (c *Client) GetOrganizationInvitationByEmail(org_id string, email string) (IAMOrganizationInvitation, error) {
+func (c *Client) ListOrganizationInvitations(ctx context.Context, org_id string, filters url.Values) *ListIterator[IAMOrganizationInvitation] {
 	path := fmt.Sprintf(IAMOrganizationInvitationsEndpoint, org_id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
-	if err != nil {
-		return IAMOrganizationInvitation{}, errors.Trace(fmt.Errorf(GetOrganizationInvitationError, err.Error()))
-	}
-	err = c.checkResponse(response)
-	if err != nil {
-		return IAMOrganizationInvitation{}, errors.Trace(fmt.Errorf(GetOrganizationInvitationError, err.Error()))
-	}
-
-	var iamOrganizationInvitations []IAMOrganizationInvitation
-	err = response.JSONUnmarshall(&iamOrganizationInvitations)
-	if err != nil {
-		body, respErr := response.StringBody()
-		if respErr != nil {
-			body = "unable to parse body"
-		}
-		return IAMOrganizationInvitation{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
-	}
-	for _, ioi := range iamOrganizationInvitations {
-		if ioi.Email == email {
-			return ioi, nil
-		}
-	}
+	return newListIterator[IAMOrganizationInvitation](c, path, filters, GetOrganizationInvitationError)
+}
 
-	return IAMOrganizationInvitation{}, fmt.Errorf("organization invitation with that e-mail address was not found: %s", email)
+func (c *Client) GetOrganizationInvitationByEmail(ctx context.Context, org_id string, email string) (IAMOrganizationInvitation, error) {
+	invitations := c.ListOrganizationInvitations(ctx, org_id, url.Values{"email": {email}})
+	return findOne(ctx, invitations, "organization invitation", "e-mail address", email, func(invitation IAMOrganizationInvitation) bool {
+		return invitation.Email == email
+	})
 }
 
-func (c *Client) CreateOrganizationInvitation(org_id string, email string, permissions []string) (IAMOrganizationInvitation, error) {
+func (c *Client) CreateOrganizationInvitation(ctx context.Context, org_id string, email string, permissions []string) (IAMOrganizationInvitation, error) {
 	var iamOrganizationInvitation IAMOrganizationInvitation
 	path := fmt.Sprintf(IAMOrganizationInvitationsEndpoint, org_id)
 	type invitation = map[string]interface{}
@@ -786,14 +830,14 @@ func (c *Client) CreateOrganizationInvitation(org_id string, email string, permi
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationInvitation, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationInvitation, fmt.Errorf(CreateOrganizationInvitationError, err.Error(), path)
+		return iamOrganizationInvitation, fmt.Errorf(CreateOrganizationInvitationError, err, path)
 	}
 
 	var iamOrganizationInvitations []IAMOrganizationInvitation
@@ -809,14 +853,17 @@ func (c *Client) CreateOrganizationInvitation(org_id string, email string, permi
 	return iamOrganizationInvitations[0], nil
 }
 
-func (c *Client) DeleteOrganizationInvitation(org_id string, email string) error {
-	invitation, err := c.GetOrganizationInvitationByEmail(org_id, email)
+func (c *Client) DeleteOrganizationInvitation(ctx context.Context, org_id string, email string) error {
+	invitation, err := c.GetOrganizationInvitationByEmail(ctx, org_id, email)
+	if IsNoMatch(err) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 	path := fmt.Sprintf(IAMOrganizationInvitationEndpoint, org_id, invitation.ID)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -825,20 +872,20 @@ func (c *Client) DeleteOrganizationInvitation(org_id string, email string) error
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectError, err.Error())
+		return fmt.Errorf(DeleteOrganizationInvitationError, err)
 	}
 	return nil
 }
 
-func (c *Client) GetProjectMembership(org_id string, project_id string, id string) (IAMProjectMembership, error) {
+func (c *Client) GetProjectMembership(ctx context.Context, org_id string, project_id string, id string) (IAMProjectMembership, error) {
 	path := fmt.Sprintf(IAMProjectMembershipEndpoint, org_id, project_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMProjectMembership{}, errors.Trace(fmt.Errorf(GetProjectMembershipError, err.Error()))
+		return IAMProjectMembership{}, errors.Trace(fmt.Errorf(GetProjectMembershipError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMProjectMembership{}, errors.Trace(fmt.Errorf(GetProjectMembershipError, err.Error()))
+		return IAMProjectMembership{}, errors.Trace(fmt.Errorf(GetProjectMembershipError, err))
 	}
 
 	var iamProjectMembership IAMProjectMembership
@@ -854,36 +901,19 @@ func (c *Client) GetProjectMembership(org_id string, project_id string, id strin
 	return iamProjectMembership, nil
 }
 
-func (c *Client) GetProjectMembershipByEmail(org_id string, project_id string, email string) (IAMProjectMembership, error) {
+func (c *Client) ListProjectMemberships(ctx context.Context, org_id string, project_id string, filters url.Values) *ListIterator[IAMProjectMembership] {
 	path := fmt.Sprintf(IAMProjectMembershipsEndpoint, org_id, project_id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
-	if err != nil {
-		return IAMProjectMembership{}, errors.Trace(fmt.Errorf(GetProjectMembershipError, err.Error()))
-	}
-	err = c.checkResponse(response)
-	if err != nil {
-		return IAMProjectMembership{}, errors.Trace(fmt.Errorf(GetProjectMembershipError, err.Error()))
-	}
-
-	var iamProjectMemberships []IAMProjectMembership
-	err = response.JSONUnmarshall(&iamProjectMemberships)
-	if err != nil {
-		body, respErr := response.StringBody()
-		if respErr != nil {
-			body = "unable to parse body"
-		}
-		return IAMProjectMembership{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
-	}
-	for _, iom := range iamProjectMemberships {
-		if iom.User.Email == email {
-			return iom, nil
-		}
-	}
+	return newListIterator[IAMProjectMembership](c, path, filters, GetProjectMembershipError)
+}
 
-	return IAMProjectMembership{}, fmt.Errorf("membership with that e-mail address was not found: %s", email)
+func (c *Client) GetProjectMembershipByEmail(ctx context.Context, org_id string, project_id string, email string) (IAMProjectMembership, error) {
+	memberships := c.ListProjectMemberships(ctx, org_id, project_id, url.Values{"email": {email}})
+	return findOne(ctx, memberships, "project membership", "e-mail address", email, func(membership IAMProjectMembership) bool {
+		return membership.User.Email == email
+	})
 }
 
-func (c *Client) CreateProjectMembership(org_id string, project_id string, user_id string, permissions []string) (IAMProjectMembership, error) {
+func (c *Client) CreateProjectMembership(ctx context.Context, org_id string, project_id string, user_id string, permissions []string) (IAMProjectMembership, error) {
 	var iamProjectMembership IAMProjectMembership
 	path := fmt.Sprintf(IAMProjectMembershipPermissionsEndpoint, org_id, project_id, user_id)
 	payload, err := json.Marshal(permissions)
@@ -893,14 +923,14 @@ func (c *Client) CreateProjectMembership(org_id string, project_id string, user_
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectMembership, fmt.Errorf(CreateProjectMembershipError, err.Error())
+		return iamProjectMembership, fmt.Errorf(CreateProjectMembershipError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectMembership)
@@ -915,7 +945,7 @@ func (c *Client) CreateProjectMembership(org_id string, project_id string, user_
 	return iamProjectMembership, nil
 }
 
-func (c *Client) UpdateProjectMembership(org_id string, project_id string, user_id string, permissions []string) (IAMProjectMembership, error) {
+func (c *Client) UpdateProjectMembership(ctx context.Context, org_id string, project_id string, user_id string, permissions []string) (IAMProjectMembership, error) {
 	var iamProjectMembership IAMProjectMembership
 	path := fmt.Sprintf(IAMProjectMembershipPermissionsEndpoint, org_id, project_id, user_id)
 	payload, err := json.Marshal(permissions)
@@ -925,14 +955,14 @@ func (c *Client) UpdateProjectMembership(org_id string, project_id string, user_
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectMembership, fmt.Errorf(UpdateProjectMembershipError, err.Error())
+		return iamProjectMembership, fmt.Errorf(UpdateProjectMembershipError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectMembership)
@@ -947,10 +977,10 @@ func (c *Client) UpdateProjectMembership(org_id string, project_id string, user_
 	return iamProjectMembership, nil
 }
 
-func (c *Client) DeleteProjectMembership(org_id string, project_id string, id string) error {
+func (c *Client) DeleteProjectMembership(ctx context.Context, org_id string, project_id string, id string) error {
 	path := fmt.Sprintf(IAMProjectMembershipEndpoint, org_id, project_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -959,20 +989,20 @@ func (c *Client) DeleteProjectMembership(org_id string, project_id string, id st
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectMembershipError, err.Error())
+		return fmt.Errorf(DeleteProjectMembershipError, err)
 	}
 	return nil
 }
 
-func (c *Client) GetOrganizationServiceaccount(org_id string, id string) (IAMOrganizationServiceaccount, error) {
+func (c *Client) GetOrganizationServiceaccount(ctx context.Context, org_id string, id string) (IAMOrganizationServiceaccount, error) {
 	path := fmt.Sprintf(IAMOrganizationServiceaccountEndpoint, org_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganizationServiceaccount{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountError, err.Error()))
+		return IAMOrganizationServiceaccount{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganizationServiceaccount{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountError, err.Error()))
+		return IAMOrganizationServiceaccount{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountError, err))
 	}
 
 	var iamOrganizationServiceaccount IAMOrganizationServiceaccount
@@ -988,7 +1018,7 @@ func (c *Client) GetOrganizationServiceaccount(org_id string, id string) (IAMOrg
 	return iamOrganizationServiceaccount, nil
 }
 
-func (c *Client) CreateOrganizationServiceaccount(org_id string, name string, description string) (IAMOrganizationServiceaccount, error) {
+func (c *Client) CreateOrganizationServiceaccount(ctx context.Context, org_id string, name string, description string) (IAMOrganizationServiceaccount, error) {
 	var iamOrganizationServiceaccount IAMOrganizationServiceaccount
 
 	// create service account
@@ -1004,14 +1034,14 @@ func (c *Client) CreateOrganizationServiceaccount(org_id string, name string, de
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationServiceaccount, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationServiceaccount, fmt.Errorf(CreateOrganizationServiceaccountError, err.Error())
+		return iamOrganizationServiceaccount, fmt.Errorf(CreateOrganizationServiceaccountError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationServiceaccount)
@@ -1027,7 +1057,7 @@ func (c *Client) CreateOrganizationServiceaccount(org_id string, name string, de
 	return iamOrganizationServiceaccount, nil
 }
 
-func (c *Client) UpdateOrganizationServiceaccount(org_id string, serviceaccount_id string, name string, description string) (IAMOrganizationServiceaccount, error) {
+func (c *Client) UpdateOrganizationServiceaccount(ctx context.Context, org_id string, serviceaccount_id string, name string, description string) (IAMOrganizationServiceaccount, error) {
 	var iamOrganizationServiceaccount IAMOrganizationServiceaccount
 	// update service account
 	path := fmt.Sprintf(IAMOrganizationServiceaccountEndpoint, org_id, serviceaccount_id)
@@ -1039,14 +1069,14 @@ func (c *Client) UpdateOrganizationServiceaccount(org_id string, serviceaccount_
 
 	response, err := c.client.NewRequest(http.MethodPut, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationServiceaccount, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationServiceaccount, fmt.Errorf(UpdateOrganizationServiceaccountPermissionError, err.Error())
+		return iamOrganizationServiceaccount, fmt.Errorf(UpdateOrganizationServiceaccountError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationServiceaccount)
@@ -1062,10 +1092,10 @@ func (c *Client) UpdateOrganizationServiceaccount(org_id string, serviceaccount_
 	return iamOrganizationServiceaccount, nil
 }
 
-func (c *Client) DeleteOrganizationServiceaccount(org_id string, id string) error {
+func (c *Client) DeleteOrganizationServiceaccount(ctx context.Context, org_id string, id string) error {
 	path := fmt.Sprintf(IAMOrganizationServiceaccountEndpoint, org_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1074,22 +1104,161 @@ func (c *Client) DeleteOrganizationServiceaccount(org_id string, id string) erro
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteOrganizationServiceaccountError, err.Error())
+		return fmt.Errorf(DeleteOrganizationServiceaccountError, err)
+	}
+	return nil
+}
+
+// organization service account permissions
+
+func (c *Client) GetOrganizationServiceaccountPermissions(ctx context.Context, org_id string, serviceaccount_id string) ([]string, error) {
+	path := fmt.Sprintf(IAMOrganizationServiceaccountPermissionsEndpoint, org_id, serviceaccount_id)
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
+	if err != nil {
+		return []string{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountPermissionError, err))
+	}
+	err = c.checkResponse(response)
+	if err != nil {
+		return []string{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountPermissionError, err))
+	}
+
+	var permissions []string
+	err = response.JSONUnmarshall(&permissions)
+	if err != nil {
+		body, respErr := response.StringBody()
+		if respErr != nil {
+			body = "unable to parse body"
+		}
+		return []string{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
+	}
+	return permissions, nil
+}
+
+func (c *Client) CreateOrganizationServiceaccountPermissions(ctx context.Context, org_id string, serviceaccount_id string, permissions []string) ([]string, error) {
+	path := fmt.Sprintf(IAMOrganizationServiceaccountPermissionsEndpoint, org_id, serviceaccount_id)
+	payload, err := json.Marshal(permissions)
+	if err != nil {
+		return []string{}, err
+	}
+
+	response, err := c.client.NewRequest(http.MethodPost, path).
+		UseJSONPayload(payload).
+		Do(ctx)
+	if err != nil {
+		return []string{}, err
+	}
+
+	err = c.checkResponse(response)
+	if err != nil {
+		return []string{}, fmt.Errorf(CreateOrganizationServiceaccountPermissionError, err)
+	}
+	return permissions, nil
+}
+
+func (c *Client) UpdateOrganizationServiceaccountPermissions(ctx context.Context, org_id string, serviceaccount_id string, permissions []string) ([]string, error) {
+	path := fmt.Sprintf(IAMOrganizationServiceaccountPermissionsEndpoint, org_id, serviceaccount_id)
+	payload, err := json.Marshal(permissions)
+	if err != nil {
+		return []string{}, err
+	}
+
+	response, err := c.client.NewRequest(http.MethodPut, path).
+		UseJSONPayload(payload).
+		Do(ctx)
+	if err != nil {
+		return []string{}, err
+	}
+
+	err = c.checkResponse(response)
+	if err != nil {
+		return []string{}, fmt.Errorf(UpdateOrganizationServiceaccountPermissionError, err)
+	}
+	return permissions, nil
+}
+
+// organization service account secrets
+
+func (c *Client) GetOrganizationServiceaccountSecret(ctx context.Context, org_id string, serviceaccount_id string, id string) (IAMOrganizationServiceaccountSecret, error) {
+	path := fmt.Sprintf(IAMOrganizationServiceaccountSecretEndpoint, org_id, serviceaccount_id, id)
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
+	if err != nil {
+		return IAMOrganizationServiceaccountSecret{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountSecretError, err))
+	}
+	err = c.checkResponse(response)
+	if err != nil {
+		return IAMOrganizationServiceaccountSecret{}, errors.Trace(fmt.Errorf(GetOrganizationServiceaccountSecretError, err))
+	}
+
+	var iamOrganizationServiceaccountSecret IAMOrganizationServiceaccountSecret
+	err = response.JSONUnmarshall(&iamOrganizationServiceaccountSecret)
+	if err != nil {
+		body, respErr := response.StringBody()
+		if respErr != nil {
+			body = "unable to parse body"
+		}
+		return IAMOrganizationServiceaccountSecret{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
+	}
+
+	return iamOrganizationServiceaccountSecret, nil
+}
+
+func (c *Client) CreateOrganizationServiceaccountSecret(ctx context.Context, org_id string, serviceaccount_id string) (IAMOrganizationServiceaccountSecret, error) {
+	var iamOrganizationServiceaccountSecret IAMOrganizationServiceaccountSecret
+	path := fmt.Sprintf(IAMOrganizationServiceaccountSecretsEndpoint, org_id, serviceaccount_id)
+
+	response, err := c.client.NewRequest(http.MethodPost, path).
+		Do(ctx)
+	if err != nil {
+		return iamOrganizationServiceaccountSecret, err
+	}
+
+	err = c.checkResponse(response)
+	if err != nil {
+		return iamOrganizationServiceaccountSecret, fmt.Errorf(CreateOrganizationServiceaccountSecretError, err)
+	}
+
+	// JSONUnmarshall echoes the body in its errors, which holds the secret
+	err = response.JSONUnmarshall2(&iamOrganizationServiceaccountSecret)
+	if err != nil {
+		return IAMOrganizationServiceaccountSecret{}, fmt.Errorf(CreateOrganizationServiceaccountSecretError, fmt.Errorf("could not decode the response: %w (code: %d)", err, response.StatusCode))
+	}
+	if iamOrganizationServiceaccountSecret.ID == "" {
+		return IAMOrganizationServiceaccountSecret{}, fmt.Errorf(CreateOrganizationServiceaccountSecretError, fmt.Errorf("the response does not contain the id of the secret (code: %d)", response.StatusCode))
+	}
+	if iamOrganizationServiceaccountSecret.Secret == "" {
+		return iamOrganizationServiceaccountSecret, fmt.Errorf(CreateOrganizationServiceaccountSecretError, fmt.Errorf("the response does not contain the secret of %s", iamOrganizationServiceaccountSecret.ID))
+	}
+	return iamOrganizationServiceaccountSecret, nil
+}
+
+func (c *Client) DeleteOrganizationServiceaccountSecret(ctx context.Context, org_id string, serviceaccount_id string, id string) error {
+	path := fmt.Sprintf(IAMOrganizationServiceaccountSecretEndpoint, org_id, serviceaccount_id, id)
+	response, err := c.client.NewRequest(http.MethodDelete, path).
+		Do(ctx)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	err = c.checkResponse(response)
+	if err != nil {
+		return fmt.Errorf(DeleteOrganizationServiceaccountSecretError, err)
 	}
 	return nil
 }
 
 // organization teams
 
-func (c *Client) GetOrganizationTeam(org_id string, id string) (IAMOrganizationTeam, error) {
+func (c *Client) GetOrganizationTeam(ctx context.Context, org_id string, id string) (IAMOrganizationTeam, error) {
 	path := fmt.Sprintf(IAMOrganizationTeamEndpoint, org_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganizationTeam{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err.Error()))
+		return IAMOrganizationTeam{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganizationTeam{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err.Error()))
+		return IAMOrganizationTeam{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err))
 	}
 
 	var iamOrganizationTeam IAMOrganizationTeam
@@ -1105,15 +1274,20 @@ func (c *Client) GetOrganizationTeam(org_id string, id string) (IAMOrganizationT
 	return iamOrganizationTeam, nil
 }
 
-func (c *Client) GetOrganizationTeamPermissions(org_id string, id string) (IAMOrganizationTeamPermissions, error) {
+func (c *Client) ListOrganizationTeams(ctx context.Context, org_id string, filters url.Values) *ListIterator[IAMOrganizationTeam] {
+	path := fmt.Sprintf(IAMOrganizationTeamsEndpoint, org_id)
+	return newListIterator[IAMOrganizationTeam](c, path, filters, GetOrganizationTeamError)
+}
+
+func (c *Client) GetOrganizationTeamPermissions(ctx context.Context, org_id string, id string) (IAMOrganizationTeamPermissions, error) {
 	path := fmt.Sprintf(IAMOrganizationTeamPermissionsEndpoint, org_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganizationTeamPermissions{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err.Error()))
+		return IAMOrganizationTeamPermissions{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganizationTeamPermissions{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err.Error()))
+		return IAMOrganizationTeamPermissions{}, errors.Trace(fmt.Errorf(GetOrganizationTeamError, err))
 	}
 
 	var iamOrganizationTeamPermissions IAMOrganizationTeamPermissions
@@ -1129,7 +1303,7 @@ func (c *Client) GetOrganizationTeamPermissions(org_id string, id string) (IAMOr
 	return iamOrganizationTeamPermissions, nil
 }
 
-func (c *Client) CreateOrganizationTeam(org_id string, name string, description string, tags []string) (IAMOrganizationTeam, error) {
+func (c *Client) CreateOrganizationTeam(ctx context.Context, org_id string, name string, description string, tags []string) (IAMOrganizationTeam, error) {
 	var iamOrganizationTeam IAMOrganizationTeam
 	path := fmt.Sprintf(IAMOrganizationTeamsEndpoint, org_id)
 	type serviceaccount = map[string]interface{}
@@ -1141,14 +1315,14 @@ func (c *Client) CreateOrganizationTeam(org_id string, name string, description
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationTeam, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationTeam, fmt.Errorf(CreateOrganizationTeamError, err.Error(), path)
+		return iamOrganizationTeam, fmt.Errorf(CreateOrganizationTeamError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationTeam)
@@ -1163,7 +1337,7 @@ func (c *Client) CreateOrganizationTeam(org_id string, name string, description
 	return iamOrganizationTeam, nil
 }
 
-func (c *Client) UpdateOrganizationTeam(org_id string, team_id string, name string, description string, tags []string) (IAMOrganizationTeam, error) {
+func (c *Client) UpdateOrganizationTeam(ctx context.Context, org_id string, team_id string, name string, description string, tags []string) (IAMOrganizationTeam, error) {
 	var iamOrganizationTeam IAMOrganizationTeam
 	path := fmt.Sprintf(IAMOrganizationTeamEndpoint, org_id, team_id)
 	type serviceaccount = map[string]interface{}
@@ -1178,14 +1352,14 @@ func (c *Client) UpdateOrganizationTeam(org_id string, team_id string, name stri
 
 	response, err := c.client.NewRequest(http.MethodPut, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationTeam, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationTeam, fmt.Errorf(UpdateOrganizationTeamError, err.Error())
+		return iamOrganizationTeam, fmt.Errorf(UpdateOrganizationTeamError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationTeam)
@@ -1200,10 +1374,10 @@ func (c *Client) UpdateOrganizationTeam(org_id string, team_id string, name stri
 	return iamOrganizationTeam, nil
 }
 
-func (c *Client) DeleteOrganizationTeam(org_id string, id string) error {
+func (c *Client) DeleteOrganizationTeam(ctx context.Context, org_id string, id string) error {
 	path := fmt.Sprintf(IAMOrganizationTeamEndpoint, org_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1212,22 +1386,27 @@ func (c *Client) DeleteOrganizationTeam(org_id string, id string) error {
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteOrganizationTeamError, err.Error())
+		return fmt.Errorf(DeleteOrganizationTeamError, err)
 	}
 	return nil
 }
 
 // organization contacts
 
-func (c *Client) GetOrganizationContact(org_id string, id string) (IAMOrganizationContact, error) {
+func (c *Client) ListOrganizationContacts(ctx context.Context, org_id string, filters url.Values) *ListIterator[IAMOrganizationContact] {
+	path := fmt.Sprintf(IAMOrganizationContactsEndpoint, org_id)
+	return newListIterator[IAMOrganizationContact](c, path, filters, GetOrganizationContactError)
+}
+
+func (c *Client) GetOrganizationContact(ctx context.Context, org_id string, id string) (IAMOrganizationContact, error) {
 	path := fmt.Sprintf(IAMOrganizationContactEndpoint, org_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganizationContact{}, errors.Trace(fmt.Errorf(GetOrganizationContactError, err.Error()))
+		return IAMOrganizationContact{}, errors.Trace(fmt.Errorf(GetOrganizationContactError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganizationContact{}, errors.Trace(fmt.Errorf(GetOrganizationContactError, err.Error()))
+		return IAMOrganizationContact{}, errors.Trace(fmt.Errorf(GetOrganizationContactError, err))
 	}
 
 	var iamOrganizationContact IAMOrganizationContact
@@ -1243,7 +1422,7 @@ func (c *Client) GetOrganizationContact(org_id string, id string) (IAMOrganizati
 	return iamOrganizationContact, nil
 }
 
-func (c *Client) CreateOrganizationContact(org_id string, first_name string, last_name string, notes string, email string, phone string, roles []string) (IAMOrganizationContact, error) {
+func (c *Client) CreateOrganizationContact(ctx context.Context, org_id string, first_name string, last_name string, notes string, email string, phone string, roles []string) (IAMOrganizationContact, error) {
 	var iamOrganizationContact IAMOrganizationContact
 	path := fmt.Sprintf(IAMOrganizationContactsEndpoint, org_id)
 	type serviceaccount = map[string]interface{}
@@ -1258,14 +1437,14 @@ func (c *Client) CreateOrganizationContact(org_id string, first_name string, las
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationContact, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationContact, fmt.Errorf(CreateOrganizationContactError, err.Error(), path)
+		return iamOrganizationContact, fmt.Errorf(CreateOrganizationContactError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationContact)
@@ -1280,7 +1459,7 @@ func (c *Client) CreateOrganizationContact(org_id string, first_name string, las
 	return iamOrganizationContact, nil
 }
 
-func (c *Client) UpdateOrganizationContact(org_id string, team_id string, first_name string, last_name string, notes string, email string, phone string, roles []string) (IAMOrganizationContact, error) {
+func (c *Client) UpdateOrganizationContact(ctx context.Context, org_id string, team_id string, first_name string, last_name string, notes string, email string, phone string, roles []string) (IAMOrganizationContact, error) {
 	var iamOrganizationContact IAMOrganizationContact
 	path := fmt.Sprintf(IAMOrganizationContactEndpoint, org_id, team_id)
 	type serviceaccount = map[string]interface{}
@@ -1298,14 +1477,14 @@ func (c *Client) UpdateOrganizationContact(org_id string, team_id string, first_
 
 	response, err := c.client.NewRequest(http.MethodPut, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationContact, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationContact, fmt.Errorf(UpdateOrganizationContactError, err.Error())
+		return iamOrganizationContact, fmt.Errorf(UpdateOrganizationContactError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationContact)
@@ -1320,10 +1499,10 @@ func (c *Client) UpdateOrganizationContact(org_id string, team_id string, first_
 	return iamOrganizationContact, nil
 }
 
-func (c *Client) DeleteOrganizationContact(org_id string, id string) error {
+func (c *Client) DeleteOrganizationContact(ctx context.Context, org_id string, id string) error {
 	path := fmt.Sprintf(IAMOrganizationContactEndpoint, org_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1332,22 +1511,22 @@ func (c *Client) DeleteOrganizationContact(org_id string, id string) error {
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteOrganizationContactError, err.Error())
+		return fmt.Errorf(DeleteOrganizationContactError, err)
 	}
 	return nil
 }
 
 // project team permissions
 
-func (c *Client) GetProjectTeamPermissions(org_id string, project_id string, team_id string) ([]string, error) {
+func (c *Client) GetProjectTeamPermissions(ctx context.Context, org_id string, project_id string, team_id string) ([]string, error) {
 	path := fmt.Sprintf(IAMProjectTeamPermissionsEndpoint, org_id, project_id, team_id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return []string{}, errors.Trace(fmt.Errorf(GetProjectTeamPermissionsError, err.Error()))
+		return []string{}, errors.Trace(fmt.Errorf(GetProjectTeamPermissionsError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return []string{}, errors.Trace(fmt.Errorf(GetProjectTeamPermissionsError, err.Error()))
+		return []string{}, errors.Trace(fmt.Errorf(GetProjectTeamPermissionsError, err))
 	}
 
 	var permissions []string
@@ -1362,21 +1541,21 @@ func (c *Client) GetProjectTeamPermissions(org_id string, project_id string, tea
 	return permissions, nil
 }
 
-func (c *Client) CreateProjectTeamPermissions(org_id string, project_id string, team_id string, permissions []string) (IAMProjectTeamPermissions, error) {
+func (c *Client) CreateProjectTeamPermissions(ctx context.Context, org_id string, project_id string, team_id string, permissions []string) (IAMProjectTeamPermissions, error) {
 	var iamProjectTeamPermissions IAMProjectTeamPermissions
 	path := fmt.Sprintf(IAMProjectTeamPermissionsEndpoint, org_id, project_id, team_id)
 	payload, err := json.Marshal(permissions)
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectTeamPermissions, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectTeamPermissions, fmt.Errorf(CreateProjectTeamPermissionsError, err.Error(), path)
+		return iamProjectTeamPermissions, fmt.Errorf(CreateProjectTeamPermissionsError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectTeamPermissions)
@@ -1391,7 +1570,7 @@ func (c *Client) CreateProjectTeamPermissions(org_id string, project_id string,
 	return iamProjectTeamPermissions, nil
 }
 
-func (c *Client) UpdateProjectTeamPermissions(org_id string, project_id string, team_id string, permissions []string) ([]string, error) {
+func (c *Client) UpdateProjectTeamPermissions(ctx context.Context, org_id string, project_id string, team_id string, permissions []string) ([]string, error) {
 	path := fmt.Sprintf(IAMProjectTeamPermissionsEndpoint, org_id, project_id, team_id)
 	payload, err := json.Marshal(permissions)
 	if err != nil {
@@ -1400,24 +1579,24 @@ func (c *Client) UpdateProjectTeamPermissions(org_id string, project_id string,
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return []string{}, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return []string{}, fmt.Errorf(UpdateProjectTeamPermissionsError, err.Error())
+		return []string{}, fmt.Errorf(UpdateProjectTeamPermissionsError, err)
 	}
 	return permissions, nil
 }
 
-func (c *Client) DeleteProjectTeamPermissions(org_id string, project_id string, team_id string) error {
+func (c *Client) DeleteProjectTeamPermissions(ctx context.Context, org_id string, project_id string, team_id string) error {
 	path := fmt.Sprintf(IAMProjectTeamPermissionsEndpoint, org_id, project_id, team_id)
 	payload, err := json.Marshal([]string{})
 	response, err := c.client.NewRequest(http.MethodPatch, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1426,22 +1605,22 @@ func (c *Client) DeleteProjectTeamPermissions(org_id string, project_id string,
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectTeamPermissionsError, err.Error())
+		return fmt.Errorf(DeleteProjectTeamPermissionsError, err)
 	}
 	return nil
 }
 
 // organization team memberships
 
-func (c *Client) GetOrganizationTeamMembership(org_id string, team_id string, id string) (IAMOrganizationTeamMembership, error) {
+func (c *Client) GetOrganizationTeamMembership(ctx context.Context, org_id string, team_id string, id string) (IAMOrganizationTeamMembership, error) {
 	path := fmt.Sprintf(IAMOrganizationTeamMembershipEndpoint, org_id, team_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMOrganizationTeamMembership{}, errors.Trace(fmt.Errorf(GetOrganizationTeamMembershipError, err.Error()))
+		return IAMOrganizationTeamMembership{}, errors.Trace(fmt.Errorf(GetOrganizationTeamMembershipError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMOrganizationTeamMembership{}, errors.Trace(fmt.Errorf(GetOrganizationTeamMembershipError, err.Error()))
+		return IAMOrganizationTeamMembership{}, errors.Trace(fmt.Errorf(GetOrganizationTeamMembershipError, err))
 	}
 
 	var iamOrganizationTeamMembership IAMOrganizationTeamMembership
@@ -1457,19 +1636,24 @@ func (c *Client) GetOrganizationTeamMembership(org_id string, team_id string, id
 	return iamOrganizationTeamMembership, nil
 }
 
-func (c *Client) CreateOrganizationTeamMembership(org_id string, team_id string, member_id string) (IAMOrganizationTeamMembership, error) {
+func (c *Client) ListOrganizationTeamMemberships(ctx context.Context, org_id string, team_id string, filters url.Values) *ListIterator[IAMOrganizationTeamMembership] {
+	path := fmt.Sprintf(IAMOrganizationTeamMembershipsEndpoint, org_id, team_id)
+	return newListIterator[IAMOrganizationTeamMembership](c, path, filters, GetOrganizationTeamMembershipError)
+}
+
+func (c *Client) CreateOrganizationTeamMembership(ctx context.Context, org_id string, team_id string, member_id string) (IAMOrganizationTeamMembership, error) {
 	var iamOrganizationTeamMembership IAMOrganizationTeamMembership
 	path := fmt.Sprintf(IAMOrganizationTeamMembershipEndpoint, org_id, team_id, member_id)
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationTeamMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationTeamMembership, fmt.Errorf(CreateOrganizationTeamMembershipError, err.Error(), path)
+		return iamOrganizationTeamMembership, fmt.Errorf(CreateOrganizationTeamMembershipError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationTeamMembership)
@@ -1484,19 +1668,19 @@ func (c *Client) CreateOrganizationTeamMembership(org_id string, team_id string,
 	return iamOrganizationTeamMembership, nil
 }
 
-func (c *Client) UpdateOrganizationTeamMembership(org_id string, team_id string, member_id string) (IAMOrganizationTeamMembership, error) {
+func (c *Client) UpdateOrganizationTeamMembership(ctx context.Context, org_id string, team_id string, member_id string) (IAMOrganizationTeamMembership, error) {
 	var iamOrganizationTeamMembership IAMOrganizationTeamMembership
 	path := fmt.Sprintf(IAMOrganizationTeamMembershipEndpoint, org_id, team_id, member_id)
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamOrganizationTeamMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamOrganizationTeamMembership, fmt.Errorf(UpdateOrganizationTeamMembershipError, err.Error())
+		return iamOrganizationTeamMembership, fmt.Errorf(UpdateOrganizationTeamMembershipError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamOrganizationTeamMembership)
@@ -1511,10 +1695,10 @@ func (c *Client) UpdateOrganizationTeamMembership(org_id string, team_id string,
 	return iamOrganizationTeamMembership, nil
 }
 
-func (c *Client) DeleteOrganizationTeamMembership(org_id string, team_id string, id string) error {
+func (c *Client) DeleteOrganizationTeamMembership(ctx context.Context, org_id string, team_id string, id string) error {
 	path := fmt.Sprintf(IAMOrganizationTeamMembershipEndpoint, org_id, team_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1523,22 +1707,22 @@ func (c *Client) DeleteOrganizationTeamMembership(org_id string, team_id string,
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteOrganizationTeamMembershipError, err.Error())
+		return fmt.Errorf(DeleteOrganizationTeamMembershipError, err)
 	}
 	return nil
 }
 
 // project team memberships
 
-func (c *Client) GetProjectTeamMembership(org_id string, project_id string, team_id string, id string) (IAMProjectTeamMembership, error) {
+func (c *Client) GetProjectTeamMembership(ctx context.Context, org_id string, project_id string, team_id string, id string) (IAMProjectTeamMembership, error) {
 	path := fmt.Sprintf(IAMProjectTeamMembershipEndpoint, org_id, project_id, team_id, id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMProjectTeamMembership{}, errors.Trace(fmt.Errorf(GetProjectTeamMembershipError, err.Error()))
+		return IAMProjectTeamMembership{}, errors.Trace(fmt.Errorf(GetProjectTeamMembershipError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMProjectTeamMembership{}, errors.Trace(fmt.Errorf(GetProjectTeamMembershipError, err.Error()))
+		return IAMProjectTeamMembership{}, errors.Trace(fmt.Errorf(GetProjectTeamMembershipError, err))
 	}
 
 	var iamProjectTeamMembership IAMProjectTeamMembership
@@ -1554,7 +1738,7 @@ func (c *Client) GetProjectTeamMembership(org_id string, project_id string, team
 	return iamProjectTeamMembership, nil
 }
 
-func (c *Client) CreateProjectTeamMembership(org_id string, project_id string, team_id string, member_id string, permissions []string) (IAMProjectTeamMembership, error) {
+func (c *Client) CreateProjectTeamMembership(ctx context.Context, org_id string, project_id string, team_id string, member_id string, permissions []string) (IAMProjectTeamMembership, error) {
 	var iamProjectTeamMembership IAMProjectTeamMembership
 	path := fmt.Sprintf(IAMProjectTeamMembershipPermissionsEndpoint, org_id, project_id, team_id, member_id)
 	type permissions_payload = map[string]interface{}
@@ -1563,14 +1747,14 @@ func (c *Client) CreateProjectTeamMembership(org_id string, project_id string, t
 	})
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectTeamMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectTeamMembership, fmt.Errorf(CreateProjectTeamMembershipError, err.Error(), path)
+		return iamProjectTeamMembership, fmt.Errorf(CreateProjectTeamMembershipError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectTeamMembership)
@@ -1585,7 +1769,7 @@ func (c *Client) CreateProjectTeamMembership(org_id string, project_id string, t
 	return iamProjectTeamMembership, nil
 }
 
-func (c *Client) UpdateProjectTeamMembership(org_id string, project_id string, team_id string, member_id string, permissions []string) (IAMProjectTeamMembership, error) {
+func (c *Client) UpdateProjectTeamMembership(ctx context.Context, org_id string, project_id string, team_id string, member_id string, permissions []string) (IAMProjectTeamMembership, error) {
 	var iamProjectTeamMembership IAMProjectTeamMembership
 	path := fmt.Sprintf(IAMProjectTeamMembershipPermissionsEndpoint, org_id, project_id, team_id, member_id)
 	type permissions_payload = map[string]interface{}
@@ -1598,14 +1782,14 @@ func (c *Client) UpdateProjectTeamMembership(org_id string, project_id string, t
 
 	response, err := c.client.NewRequest(http.MethodPatch, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectTeamMembership, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectTeamMembership, fmt.Errorf(UpdateProjectTeamMembershipError, err.Error())
+		return iamProjectTeamMembership, fmt.Errorf(UpdateProjectTeamMembershipError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectTeamMembership)
@@ -1620,10 +1804,10 @@ func (c *Client) UpdateProjectTeamMembership(org_id string, project_id string, t
 	return iamProjectTeamMembership, nil
 }
 
-func (c *Client) DeleteProjectTeamMembership(org_id string, project_id string, team_id string, member_id string) error {
+func (c *Client) DeleteProjectTeamMembership(ctx context.Context, org_id string, project_id string, team_id string, member_id string) error {
 	path := fmt.Sprintf(IAMProjectTeamMembershipPermissionsEndpoint, org_id, project_id, team_id, member_id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1632,44 +1816,26 @@ func (c *Client) DeleteProjectTeamMembership(org_id string, project_id string, t
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectTeamMembershipError, err.Error())
+		return fmt.Errorf(DeleteProjectTeamMembershipError, err)
 	}
 	return nil
 }
 
 // project s3user memberships
 
-func (c *Client) GetProjectS3User(org_id string, project_id string, id string) (IAMProjectS3User, error) {
+func (c *Client) ListProjectS3Users(ctx context.Context, org_id string, project_id string, filters url.Values) *ListIterator[IAMProjectS3User] {
 	path := fmt.Sprintf(IAMProjectS3UsersEndpoint, org_id, project_id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
-	if err != nil {
-		return IAMProjectS3User{}, errors.Trace(fmt.Errorf(GetProjectS3UserError, err.Error()))
-	}
-	err = c.checkResponse(response)
-	if err != nil {
-		return IAMProjectS3User{}, errors.Trace(fmt.Errorf(GetProjectS3UserError, err.Error()))
-	}
-
-	var iamProjectS3Users []IAMProjectS3User
-	err = response.JSONUnmarshall(&iamProjectS3Users)
-	if err != nil {
-		body, respErr := response.StringBody()
-		if respErr != nil {
-			body = "unable to parse body"
-		}
-		return IAMProjectS3User{}, errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body))
-	}
-
-	for _, ips := range iamProjectS3Users {
-		if ips.ID == id {
-			return ips, nil
-		}
-	}
+	return newListIterator[IAMProjectS3User](c, path, filters, GetProjectS3UserError)
+}
 
-	return IAMProjectS3User{}, nil
+func (c *Client) GetProjectS3User(ctx context.Context, org_id string, project_id string, id string) (IAMProjectS3User, error) {
+	s3Users := c.ListProjectS3Users(ctx, org_id, project_id, nil)
+	return findOne(ctx, s3Users, "s3 user", "id", id, func(s3User IAMProjectS3User) bool {
+		return s3User.ID == id
+	})
 }
 
-func (c *Client) CreateProjectS3User(org_id string, project_id, name string, description string) (IAMProjectS3User, error) {
+func (c *Client) CreateProjectS3User(ctx context.Context, org_id string, project_id, name string, description string) (IAMProjectS3User, error) {
 	var iamProjectS3User IAMProjectS3User
 	path := fmt.Sprintf(IAMProjectS3UsersEndpoint, org_id, project_id)
 	type s3user = map[string]interface{}
@@ -1680,14 +1846,14 @@ func (c *Client) CreateProjectS3User(org_id string, project_id, name string, des
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectS3User, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectS3User, fmt.Errorf(CreateProjectS3UserError, err.Error(), path)
+		return iamProjectS3User, fmt.Errorf(CreateProjectS3UserError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectS3User)
@@ -1702,7 +1868,7 @@ func (c *Client) CreateProjectS3User(org_id string, project_id, name string, des
 	return iamProjectS3User, nil
 }
 
-func (c *Client) UpdateProjectS3User(org_id string, project_id string, s3user_id string, name string, description string) (IAMProjectS3User, error) {
+func (c *Client) UpdateProjectS3User(ctx context.Context, org_id string, project_id string, s3user_id string, name string, description string) (IAMProjectS3User, error) {
 	var iamProjectS3User IAMProjectS3User
 	path := fmt.Sprintf(IAMProjectS3UserEndpoint, org_id, project_id, s3user_id)
 	type s3user = map[string]interface{}
@@ -1716,14 +1882,14 @@ func (c *Client) UpdateProjectS3User(org_id string, project_id string, s3user_id
 
 	response, err := c.client.NewRequest(http.MethodPut, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectS3User, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectS3User, fmt.Errorf(UpdateProjectS3UserError, err.Error())
+		return iamProjectS3User, fmt.Errorf(UpdateProjectS3UserError, err)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectS3User)
@@ -1738,10 +1904,10 @@ func (c *Client) UpdateProjectS3User(org_id string, project_id string, s3user_id
 	return iamProjectS3User, nil
 }
 
-func (c *Client) DeleteProjectS3User(org_id string, project_id string, id string) error {
+func (c *Client) DeleteProjectS3User(ctx context.Context, org_id string, project_id string, id string) error {
 	path := fmt.Sprintf(IAMProjectS3UserEndpoint, org_id, project_id, id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1750,12 +1916,12 @@ func (c *Client) DeleteProjectS3User(org_id string, project_id string, id string
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectS3UserError, err.Error())
+		return fmt.Errorf(DeleteProjectS3UserError, err)
 	}
 	return nil
 }
 
-func (c *Client) CreateProjectS3UserKey(org_id string, project_id string, s3user_id string) (IAMProjectS3UserKey, error) {
+func (c *Client) CreateProjectS3UserKey(ctx context.Context, org_id string, project_id string, s3user_id string) (IAMProjectS3UserKey, error) {
 	var iamProjectS3UserKey IAMProjectS3UserKey
 	path := fmt.Sprintf(IAMProjectS3UserKeysEndpoint, org_id, project_id, s3user_id)
 	type s3user_key = map[string]interface{}
@@ -1765,14 +1931,14 @@ func (c *Client) CreateProjectS3UserKey(org_id string, project_id string, s3user
 
 	response, err := c.client.NewRequest(http.MethodPost, path).
 		UseJSONPayload(payload).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return iamProjectS3UserKey, err
 	}
 
 	err = c.checkResponse(response)
 	if err != nil {
-		return iamProjectS3UserKey, fmt.Errorf(CreateProjectS3UserKeyError, err.Error(), path)
+		return iamProjectS3UserKey, fmt.Errorf(CreateProjectS3UserKeyError, err, path)
 	}
 
 	err = response.JSONUnmarshall(&iamProjectS3UserKey)
@@ -1787,10 +1953,10 @@ func (c *Client) CreateProjectS3UserKey(org_id string, project_id string, s3user
 	return iamProjectS3UserKey, nil
 }
 
-func (c *Client) DeleteProjectS3UserKey(org_id string, project_id string, s3user_id string, key_id string) error {
+func (c *Client) DeleteProjectS3UserKey(ctx context.Context, org_id string, project_id string, s3user_id string, key_id string) error {
 	path := fmt.Sprintf(IAMProjectS3UserKeyEndpoint, org_id, project_id, s3user_id, key_id)
 	response, err := c.client.NewRequest(http.MethodDelete, path).
-		Do()
+		Do(ctx)
 	if err != nil {
 		return err
 	}
@@ -1799,20 +1965,20 @@ func (c *Client) DeleteProjectS3UserKey(org_id string, project_id string, s3user
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return fmt.Errorf(DeleteProjectS3UserKeyError, err.Error())
+		return fmt.Errorf(DeleteProjectS3UserKeyError, err)
 	}
 	return nil
 }
 
-func (c *Client) GetProjectS3UserKey(org_id string, project_id string, s3user_id string, key_id string) (IAMProjectS3UserKey, error) {
+func (c *Client) GetProjectS3UserKey(ctx context.Context, org_id string, project_id string, s3user_id string, key_id string) (IAMProjectS3UserKey, error) {
 	path := fmt.Sprintf(IAMProjectS3UserKeyEndpoint, org_id, project_id, s3user_id, key_id)
-	response, err := c.client.NewRequest(http.MethodGet, path).Do()
+	response, err := c.client.NewRequest(http.MethodGet, path).Do(ctx)
 	if err != nil {
-		return IAMProjectS3UserKey{}, errors.Trace(fmt.Errorf(GetProjectS3UserKeyError, err.Error()))
+		return IAMProjectS3UserKey{}, errors.Trace(fmt.Errorf(GetProjectS3UserKeyError, err))
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return IAMProjectS3UserKey{}, errors.Trace(fmt.Errorf(GetProjectS3UserKeyError, err.Error()))
+		return IAMProjectS3UserKey{}, errors.Trace(fmt.Errorf(GetProjectS3UserKeyError, err))
 	}
 
 	var iamProjectS3UserKey IAMProjectS3UserKey
@@ -1,9 +1,11 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	responses "github.com/syseleven/terraform-provider-sys11iam/internal/http-responses"
@@ -52,7 +54,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganization("1")
+	ret, err := client.GetOrganization(context.Background(), "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -76,7 +78,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganization(exampleIAMOrganization)
+	ret, err := client.CreateOrganization(context.Background(), exampleIAMOrganization)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -113,7 +115,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationError() {
 			CompanyName:            "testcompany",
 		},
 	}
-	id, err := client.CreateOrganization(iAMOrganization)
+	id, err := client.CreateOrganization(context.Background(), iAMOrganization)
 	suite.Error(err) //TODO: check error message
 	iamOrg := IAMOrganization(IAMOrganization{ID: "", Name: "", Description: "", Tags: []string(nil), CreatedAt: "", IsActive: false, UpdatedAt: ""})
 	suite.Equal(id, iamOrg)
@@ -138,7 +140,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateOrganization("1", exampleIAMOrganization)
+	ret, err := client.UpdateOrganization(context.Background(), "1", exampleIAMOrganization)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -176,7 +178,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationError() {
 		},
 	}
 
-	id, err := client.UpdateOrganization("1", iAMOrganization)
+	id, err := client.UpdateOrganization(context.Background(), "1", iAMOrganization)
 	suite.Error(err) //TODO: check error message
 	iamOrg := IAMOrganization(IAMOrganization{ID: "", Name: "", Description: "", Tags: []string(nil), CreatedAt: "", IsActive: false, UpdatedAt: ""})
 	suite.Equal(id, iamOrg)
@@ -196,7 +198,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteOrganization("1")
+	err := client.DeleteOrganization(context.Background(), "1")
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -214,7 +216,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteOrganization("1")
+	err := client.DeleteOrganization(context.Background(), "1")
 	suite.Error(err)
 	mockServer.HasExpectedRequests()
 }
@@ -238,7 +240,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.GetProject("1", "1")
+	id, err := client.GetProject(context.Background(), "1", "1")
 	suite.NoError(err)
 	iamProject := IAMProject(IAMProject{ID: "1", Name: "sample-project", Description: "sample-project", Tags: []string{"sample-tag"}})
 	suite.Equal(id, iamProject)
@@ -265,7 +267,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.CreateProject("1", "sample-project", "sample-project", []string{"sample-tag"})
+	id, err := client.CreateProject(context.Background(), "1", "sample-project", "sample-project", []string{"sample-tag"})
 	suite.NoError(err)
 	iamProject := IAMProject(IAMProject{ID: "1", Name: "sample-project", Description: "sample-project", Tags: []string{"sample-tag"}})
 	suite.Equal(id, iamProject)
@@ -286,7 +288,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.CreateProject("1", "sample-project", "sample-project", []string{"sample-tag"})
+	id, err := client.CreateProject(context.Background(), "1", "sample-project", "sample-project", []string{"sample-tag"})
 	suite.Error(err) //TODO: check error message
 	iamProject := IAMProject(IAMProject{ID: "", Name: "", Description: "", Tags: []string(nil)})
 	suite.Equal(id, iamProject)
@@ -313,7 +315,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.UpdateProject("1", "1", "sample-project", "sample-project", []string{"sample-tag"})
+	id, err := client.UpdateProject(context.Background(), "1", "1", "sample-project", "sample-project", []string{"sample-tag"})
 	suite.NoError(err)
 	iamProject := IAMProject(IAMProject{ID: "1", Name: "sample-project", Description: "sample-project", Tags: []string{"sample-tag"}})
 	suite.Equal(id, iamProject)
@@ -334,7 +336,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.UpdateProject("1", "1", "sample-project", "sample-project", []string{"sample-tag"})
+	id, err := client.UpdateProject(context.Background(), "1", "1", "sample-project", "sample-project", []string{"sample-tag"})
 	suite.Error(err)
 	iamProject := IAMProject(IAMProject{ID: "", Name: "", Description: "", Tags: []string(nil)})
 	suite.Equal(id, iamProject)
@@ -353,7 +355,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteProject("1", "1")
+	err := client.DeleteProject(context.Background(), "1", "1")
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -371,7 +373,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteProject("1", "1")
+	err := client.DeleteProject(context.Background(), "1", "1")
 	suite.Error(err)
 	mockServer.HasExpectedRequests()
 }
@@ -391,7 +393,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationMembership("1", "1")
+	ret, err := client.GetOrganizationMembership(context.Background(), "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -419,7 +421,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganizationMembership("1", "1", "member", []string{"can_do"})
+	ret, err := client.CreateOrganizationMembership(context.Background(), "1", "1", "member", []string{"can_do"})
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -440,7 +442,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationMembershipError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.CreateOrganizationMembership("1", "1", "member", []string{"can_do"})
+	id, err := client.CreateOrganizationMembership(context.Background(), "1", "1", "member", []string{"can_do"})
 	suite.Error(err) //TODO: check error message
 	iamOrgMembership := IAMOrganizationMembership(IAMOrganizationMembership{Organisation: IAMOrganization{ID: "", Name: ""}, Permissions: []string(nil)})
 	suite.Equal(id, iamOrgMembership)
@@ -469,7 +471,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateOrganizationMembership("1", "1", "member", []string{"can_do"})
+	ret, err := client.UpdateOrganizationMembership(context.Background(), "1", "1", "member", []string{"can_do"})
 	suite.NoError(err)
 	//iamOrgMembership := IAMOrganizationMembership(IAMOrganizationMembership{Organisation: IAMOrganization{ID: "", Name: ""}, User: IAMOrganisationUser{ID: "", Email: ""}, Affiliation: "member", MembershipType: "service_account", Permissions: []string{"can_do"}})
 	suite.Equal(expected, ret)
@@ -488,7 +490,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationMembershipError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.UpdateOrganizationMembership("1", "1", "member", []string{"can_do"})
+	id, err := client.UpdateOrganizationMembership(context.Background(), "1", "1", "member", []string{"can_do"})
 	suite.Error(err)
 	iamOrgMembership := IAMOrganizationMembership(IAMOrganizationMembership{Organisation: IAMOrganization{ID: "", Name: ""}, Permissions: []string(nil)})
 	suite.Equal(id, iamOrgMembership)
@@ -508,7 +510,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteOrganizationMembership("1", "1")
+	err := client.DeleteOrganizationMembership(context.Background(), "1", "1")
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -532,7 +534,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationMembershipError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteOrganizationMembership("1", "1")
+	err := client.DeleteOrganizationMembership(context.Background(), "1", "1")
 	suite.Error(err)
 	mockServer.HasExpectedRequests()
 }
@@ -557,7 +559,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.GetProjectMembership("1", "1", "1")
+	id, err := client.GetProjectMembership(context.Background(), "1", "1", "1")
 	suite.NoError(err)
 	iamProjectMembership := IAMProjectMembership(IAMProjectMembership{User: IAMOrganisationUser{ID: "1", Email: "test@syseleven.net"}, Permissions: []string{"can_do"}, Project: IAMProject{ID: "1", Name: "syseleven"}})
 	suite.Equal(id, iamProjectMembership)
@@ -585,7 +587,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.CreateProjectMembership("1", "1", "1", []string{"can_do"})
+	id, err := client.CreateProjectMembership(context.Background(), "1", "1", "1", []string{"can_do"})
 	suite.NoError(err)
 	iamProjectMembership := IAMProjectMembership(IAMProjectMembership{User: IAMOrganisationUser{ID: "1", Email: "test@syseleven.net"}, Permissions: []string{"can_do"}, Project: IAMProject{ID: "1", Name: "syseleven"}})
 	suite.Equal(id, iamProjectMembership)
@@ -606,7 +608,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectMembershipError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.CreateProjectMembership("1", "1", "1", []string{"can_do"})
+	id, err := client.CreateProjectMembership(context.Background(), "1", "1", "1", []string{"can_do"})
 	suite.Error(err) //TODO: check error message
 	iamProjectMembership := IAMProjectMembership(IAMProjectMembership{User: IAMOrganisationUser{ID: "", Email: ""}, Permissions: nil, Project: IAMProject{ID: "", Name: ""}})
 	suite.Equal(id, iamProjectMembership)
@@ -634,7 +636,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ipm, err := client.UpdateProjectMembership("1", "1", "1", []string{"can_do"})
+	ipm, err := client.UpdateProjectMembership(context.Background(), "1", "1", "1", []string{"can_do"})
 	suite.NoError(err)
 	iamProjectMembership := IAMProjectMembership(IAMProjectMembership{User: IAMOrganisationUser{ID: "1", Email: "test@syseleven.net"}, Permissions: []string{"can_do"}, Project: IAMProject{ID: "1", Name: "syseleven"}})
 	suite.Equal(ipm, iamProjectMembership)
@@ -655,7 +657,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectMembershipError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	id, err := client.UpdateProjectMembership("1", "1", "1", []string{"can_do"})
+	id, err := client.UpdateProjectMembership(context.Background(), "1", "1", "1", []string{"can_do"})
 	suite.Error(err) //TODO: check error message
 	iamProjectMembership := IAMProjectMembership(IAMProjectMembership{User: IAMOrganisationUser{ID: "", Email: ""}, Permissions: nil, Project: IAMProject{ID: "", Name: ""}})
 	suite.Equal(id, iamProjectMembership)
@@ -675,7 +677,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteProjectMembership("1", "1", "1")
+	err := client.DeleteProjectMembership(context.Background(), "1", "1", "1")
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -693,7 +695,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectMembershipError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteProjectMembership("1", "1", "1")
+	err := client.DeleteProjectMembership(context.Background(), "1", "1", "1")
 	suite.Error(err)
 	mockServer.HasExpectedRequests()
 }
@@ -718,7 +720,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationMembershipByEmailSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationMembershipByEmail("1", "test@syseleven.net")
+	ret, err := client.GetOrganizationMembershipByEmail(context.Background(), "1", "test@syseleven.net")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -744,7 +746,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationInvitationByEmailSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationInvitationByEmail("1", "test@syseleven.net")
+	ret, err := client.GetOrganizationInvitationByEmail(context.Background(), "1", "test@syseleven.net")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -768,7 +770,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationTeamMembershipSuccess()
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationTeamMembership("1", "1", "1")
+	ret, err := client.GetOrganizationTeamMembership(context.Background(), "1", "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -792,7 +794,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationServiceaccountSuccess()
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationServiceaccount("1", "1")
+	ret, err := client.GetOrganizationServiceaccount(context.Background(), "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -816,7 +818,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationContactSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationContact("1", "1")
+	ret, err := client.GetOrganizationContact(context.Background(), "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -842,7 +844,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationByNameSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationByName("sample-org")
+	ret, err := client.GetOrganizationByName(context.Background(), "sample-org")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -866,7 +868,7 @@ func (suite *RestClientIAMTestSuite) TestGetOrganizationTeamSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetOrganizationTeam("1", "1")
+	ret, err := client.GetOrganizationTeam(context.Background(), "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -890,7 +892,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationServiceaccountSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganizationServiceaccount("1", "test", "test")
+	ret, err := client.CreateOrganizationServiceaccount(context.Background(), "1", "test", "test")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -916,7 +918,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectMembershipByEmailSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetProjectMembershipByEmail("1", "1", "test@syseleven.net")
+	ret, err := client.GetProjectMembershipByEmail(context.Background(), "1", "1", "test@syseleven.net")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -940,7 +942,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectTeamPermissionsSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetProjectTeamPermissions("1", "1", "1")
+	ret, err := client.GetProjectTeamPermissions(context.Background(), "1", "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -964,7 +966,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectTeamMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetProjectTeamMembership("1", "1", "1", "1")
+	ret, err := client.GetProjectTeamMembership(context.Background(), "1", "1", "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -988,7 +990,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectS3UserSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetProjectS3User("1", "1", "1")
+	ret, err := client.GetProjectS3User(context.Background(), "1", "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1012,7 +1014,7 @@ func (suite *RestClientIAMTestSuite) TestGetProjectS3UserKeySuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.GetProjectS3UserKey("1", "1", "1", "1")
+	ret, err := client.GetProjectS3UserKey(context.Background(), "1", "1", "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1036,7 +1038,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationServiceaccountSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateOrganizationServiceaccount("1", "1", "name", "desc")
+	ret, err := client.UpdateOrganizationServiceaccount(context.Background(), "1", "1", "name", "desc")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1057,7 +1059,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationServiceaccountSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err := client.DeleteOrganizationServiceaccount("1", "1")
+	err := client.DeleteOrganizationServiceaccount(context.Background(), "1", "1")
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1080,7 +1082,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationTeamMembershipSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganizationTeamMembership("1", "1", "1")
+	ret, err := client.CreateOrganizationTeamMembership(context.Background(), "1", "1", "1")
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1104,7 +1106,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectTeamPermissionsSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateProjectTeamPermissions("1", "1", "1", []string{"can_do"})
+	ret, err := client.CreateProjectTeamPermissions(context.Background(), "1", "1", "1", []string{"can_do"})
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1129,7 +1131,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationInvitationSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganizationInvitation(examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.CreateOrganizationInvitation(context.Background(), examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1154,7 +1156,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationContactSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganizationContact(examplestring, examplestring, examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.CreateOrganizationContact(context.Background(), examplestring, examplestring, examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1179,7 +1181,7 @@ func (suite *RestClientIAMTestSuite) TestCreateOrganizationTeamSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateOrganizationTeam(examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.CreateOrganizationTeam(context.Background(), examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1204,7 +1206,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectTeamMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateProjectTeamMembership(examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.CreateProjectTeamMembership(context.Background(), examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1229,7 +1231,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectS3UserSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateProjectS3User(examplestring, examplestring, examplestring, examplestring)
+	ret, err := client.CreateProjectS3User(context.Background(), examplestring, examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1254,7 +1256,7 @@ func (suite *RestClientIAMTestSuite) TestCreateProjectS3UserKeySuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.CreateProjectS3UserKey(examplestring, examplestring, examplestring)
+	ret, err := client.CreateProjectS3UserKey(context.Background(), examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1279,7 +1281,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationTeamMembershipSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateOrganizationTeamMembership(examplestring, examplestring, examplestring)
+	ret, err := client.UpdateOrganizationTeamMembership(context.Background(), examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1304,7 +1306,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectTeamPermissionsSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateProjectTeamPermissions(examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.UpdateProjectTeamPermissions(context.Background(), examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1329,7 +1331,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectTeamMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateProjectTeamMembership(examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.UpdateProjectTeamMembership(context.Background(), examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1354,7 +1356,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationContactSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateOrganizationContact(examplestring, examplestring, examplestring, examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.UpdateOrganizationContact(context.Background(), examplestring, examplestring, examplestring, examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1379,7 +1381,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateOrganizationTeamSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateOrganizationTeam(examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
+	ret, err := client.UpdateOrganizationTeam(context.Background(), examplestring, examplestring, examplestring, examplestring, exampleSlicedstring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1404,7 +1406,7 @@ func (suite *RestClientIAMTestSuite) TestUpdateProjectS3UserSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	ret, err := client.UpdateProjectS3User(examplestring, examplestring, examplestring, examplestring, examplestring)
+	ret, err := client.UpdateProjectS3User(context.Background(), examplestring, examplestring, examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	suite.Equal(expected, ret)
 	mockServer.HasExpectedRequests()
@@ -1428,7 +1430,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationTeamMembershipSuccess
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteOrganizationTeamMembership(examplestring, examplestring, examplestring)
+	err = client.DeleteOrganizationTeamMembership(context.Background(), examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1451,7 +1453,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectTeamPermissionsSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteProjectTeamPermissions(examplestring, examplestring, examplestring)
+	err = client.DeleteProjectTeamPermissions(context.Background(), examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1481,7 +1483,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationInvitationSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteOrganizationInvitation(examplestring, "test@syseleven.net")
+	err = client.DeleteOrganizationInvitation(context.Background(), examplestring, "test@syseleven.net")
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1504,7 +1506,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectTeamMembershipSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteProjectTeamMembership(examplestring, examplestring, examplestring, examplestring)
+	err = client.DeleteProjectTeamMembership(context.Background(), examplestring, examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1527,7 +1529,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationContactSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteOrganizationContact(examplestring, examplestring)
+	err = client.DeleteOrganizationContact(context.Background(), examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1550,7 +1552,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteOrganizationTeamSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteOrganizationTeam(examplestring, examplestring)
+	err = client.DeleteOrganizationTeam(context.Background(), examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1573,7 +1575,7 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectS3UserSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteProjectS3User(examplestring, examplestring, examplestring)
+	err = client.DeleteProjectS3User(context.Background(), examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
@@ -1596,11 +1598,398 @@ func (suite *RestClientIAMTestSuite) TestDeleteProjectS3UserKeySuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
 
-	err = client.DeleteProjectS3UserKey(examplestring, examplestring, examplestring, examplestring)
+	err = client.DeleteProjectS3UserKey(context.Background(), examplestring, examplestring, examplestring, examplestring)
 	suite.NoError(err)
 	mockServer.HasExpectedRequests()
 }
 
+func (suite *RestClientIAMTestSuite) TestGetOrganizationNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody([]byte(`{"detail": "not found"}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.GetOrganization(context.Background(), "1")
+	suite.Error(err)
+	suite.True(IsNotFound(err))
+	suite.False(IsConflict(err))
+	suite.False(IsForbidden(err))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestCreateOrganizationFieldErrors() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("POST", "/v1/orgs").
+			ReturnWithCode(http.StatusUnprocessableEntity).
+			ReturnWithHeaders(map[string]string{
+				"X-Request-Id": "some-request-id",
+			}).
+			ReturnWithBody([]byte(`{"errors": [{"field": "name", "message": "already taken"}]}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.CreateOrganization(context.Background(), exampleIAMOrganization)
+	var apiError *APIError
+	suite.ErrorAs(err, &apiError)
+	suite.Equal(http.StatusUnprocessableEntity, apiError.StatusCode)
+	suite.Equal(http.MethodPost, apiError.Method)
+	suite.Equal(mockServer.URL+"/v1/orgs", apiError.URL)
+	suite.Equal("some-request-id", apiError.RequestID)
+	suite.Equal([]FieldError{{Field: "name", Message: "already taken"}}, apiError.FieldErrors)
+	suite.Contains(err.Error(), "could not create organization: unexpected response from iam service: HTTP 422")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetOrganizationMembershipByEmailFollowsLinkHeader() {
+	otherMembership := exampleIAMOrganizationMembership
+	otherMembership.User = IAMOrganisationUser{ID: "2", Email: "other@syseleven.net"}
+	firstPage, _ := json.Marshal([]IAMOrganizationMembership{otherMembership})
+	secondPage, _ := json.Marshal([]IAMOrganizationMembership{exampleIAMOrganizationMembership})
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithHeaders(map[string]string{
+				"Link": `</v2/orgs/1/memberships?page=2>; rel="next"`,
+			}).
+			ReturnWithBody(firstPage),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			WithQueryParameters(map[string]string{"page": "2"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(secondPage),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.GetOrganizationMembershipByEmail(context.Background(), "1", "test@syseleven.net")
+	suite.NoError(err)
+	suite.Equal(exampleIAMOrganizationMembership, ret)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetOrganizationInvitationByEmailNoMatch() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody([]byte(`{"detail": "not found"}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.GetOrganizationInvitationByEmail(context.Background(), "1", "test@syseleven.net")
+	suite.True(IsNoMatch(err))
+
+	_, err = client.GetOrganizationInvitationByEmail(context.Background(), "1", "test@syseleven.net")
+	suite.False(IsNoMatch(err))
+	suite.True(IsStatus(err, http.StatusNotFound))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestListOrganizationsRejectsNextLinkToOtherHost() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"items": [{"id": "1", "name": "first", "tags": [], "created_at": "date", "updated_at": "date"}], "next": "https://example.com/v1/orgs?page=2"}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.ListOrganizations(context.Background(), nil).All(context.Background())
+	suite.ErrorContains(err, "points to a different host")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestListOrganizationsFollowsCursor() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"items": [{"id": "1", "name": "first", "tags": [], "created_at": "date", "updated_at": "date"}], "next_cursor": "abc"}`)),
+		responses.Expect("GET", "/v1/orgs").
+			WithQueryParameters(map[string]string{"cursor": "abc"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"items": [{"id": "2", "name": "second", "tags": [], "created_at": "date", "updated_at": "date"}], "next_cursor": null}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.ListOrganizations(context.Background(), nil).All(context.Background())
+	suite.NoError(err)
+	suite.Len(ret, 2)
+	suite.Equal("first", ret[0].Name)
+	suite.Equal("second", ret[1].Name)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestListProjectS3UsersFollowsOffset() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/1/s3-users").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"results": [{"id": "1", "name": "first", "description": "", "keys": []}], "offset": 0, "limit": 1, "total": 2}`)),
+		responses.Expect("GET", "/v2/orgs/1/projects/1/s3-users").
+			WithQueryParameters(map[string]string{"offset": "1", "limit": "1"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"results": [{"id": "2", "name": "second", "description": "", "keys": []}], "offset": 1, "limit": 1, "total": 2}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.GetProjectS3User(context.Background(), "1", "1", "2")
+	suite.NoError(err)
+	suite.Equal("second", ret.Name)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestListStopsOnRepeatedPage() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"data": [], "next": "/v1/orgs/1/invitations?page=2"}`)),
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"data": [], "next": "/v1/orgs/1/invitations?page=2"}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.ListOrganizationInvitations(context.Background(), "1", nil).All(context.Background())
+	suite.NoError(err)
+	suite.Empty(ret)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetOrganizationByNameFilters() {
+	otherOrganization := exampleIAMOrganization
+	otherOrganization.ID = "2"
+	otherOrganization.Name = "other-org"
+	sampleResponse, _ := json.Marshal([]IAMOrganization{otherOrganization, exampleIAMOrganization})
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			WithQueryParameters(map[string]string{"name": "sample-org"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(sampleResponse),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.GetOrganizationByName(context.Background(), "sample-org")
+	suite.NoError(err)
+	suite.Equal(exampleIAMOrganization, ret)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetOrganizationByNameNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.GetOrganizationByName(context.Background(), "sample-org")
+	suite.EqualError(err, `organization with name "sample-org" was not found`)
+	suite.True(IsNotFound(err))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetOrganizationMembershipByEmailMultipleMatches() {
+	sampleResponse, _ := json.Marshal([]IAMOrganizationMembership{exampleIAMOrganizationMembership, exampleIAMOrganizationMembership})
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			WithQueryParameters(map[string]string{"email": "test@syseleven.net"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(sampleResponse),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.GetOrganizationMembershipByEmail(context.Background(), "1", "test@syseleven.net")
+	suite.EqualError(err, `2 objects of kind organization membership with e-mail address "test@syseleven.net" were found, expected exactly one`)
+	suite.True(IsMultipleMatches(err))
+	suite.False(IsNotFound(err))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetPermissionCatalogIsCached() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[{"name": "can_do", "scope": "organization", "description": ""}, {"name": "will_do", "scope": "project", "description": ""}]`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	permissions, err := client.GetPermissionCatalog(context.Background())
+	suite.NoError(err)
+	suite.Equal([]string{"can_do"}, PermissionNames(permissions, OrganizationPermissionScope))
+	suite.Equal([]string{"will_do"}, PermissionNames(permissions, ProjectPermissionScope))
+
+	// the second call is served from the cache
+	permissions, err = client.GetPermissionCatalog(context.Background())
+	suite.NoError(err)
+	suite.Len(permissions, 2)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestGetPermissionCatalogRetriesFailures() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusInternalServerError).
+			ReturnWithBody([]byte(`{"detail": "internal server error"}`)),
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[{"name": "can_do", "scope": "organization", "description": ""}]`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.GetPermissionCatalog(context.Background())
+	suite.Error(err)
+	suite.NotErrorIs(err, ErrPermissionCatalogUnavailable)
+
+	// the failure is remembered for a while
+	_, err = client.GetPermissionCatalog(context.Background())
+	suite.ErrorIs(err, ErrPermissionCatalogUnavailable)
+
+	// and retried afterwards
+	client.permissions.retryAt = time.Now()
+	permissions, err := client.GetPermissionCatalog(context.Background())
+	suite.NoError(err)
+	suite.Len(permissions, 1)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestOrganizationInvitationExpiresAt() {
+	for expirationDate, expected := range map[string]time.Time{
+		"2024-05-01T12:30:00Z":       time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		"2024-05-01T14:30:00+02:00":  time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		"2024-05-01T12:30:00.123456": time.Date(2024, 5, 1, 12, 30, 0, 123456000, time.UTC),
+		"2024-05-01":                 time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		expiresAt, err := IAMOrganizationInvitation{ExpirationDate: expirationDate}.ExpiresAt()
+		suite.NoError(err, expirationDate)
+		suite.True(expected.Equal(expiresAt), expirationDate)
+	}
+
+	_, err := IAMOrganizationInvitation{ID: "1", ExpirationDate: "soon"}.ExpiresAt()
+	suite.ErrorContains(err, `invalid expiration date "soon" of invitation 1`)
+}
+
+func (suite *RestClientIAMTestSuite) TestUpdateOrganizationServiceaccountPermissionsSuccess() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPut, "/v2/orgs/1/service-accounts/2/permissions").
+			WithHeaders(map[string]string{
+				"Authorization": "Bearer testtoken",
+			}).
+			ReturnWithCode(http.StatusOK),
+		responses.Expect(http.MethodGet, "/v2/orgs/1/service-accounts/2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`["can_manage_teams"]`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.UpdateOrganizationServiceaccountPermissions(context.Background(), "1", "2", []string{"can_manage_teams"})
+	suite.NoError(err)
+	suite.Equal([]string{"can_manage_teams"}, ret)
+
+	ret, err = client.GetOrganizationServiceaccountPermissions(context.Background(), "1", "2")
+	suite.NoError(err)
+	suite.Equal([]string{"can_manage_teams"}, ret)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestCreateOrganizationServiceaccountSecretSuccess() {
+	method := http.MethodPost
+	url := "/v2/orgs/1/service-accounts/2/secrets"
+	status := http.StatusCreated
+	expected := IAMOrganizationServiceaccountSecret{ID: "3", Secret: "s11_orgsa_secret", CreatedAt: "date"}
+	sampleResponse, err := json.Marshal(expected)
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(method, url).
+			WithHeaders(map[string]string{
+				"Authorization": "Bearer testtoken",
+			}).
+			ReturnWithCode(status).
+			ReturnWithBody([]byte(sampleResponse)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.CreateOrganizationServiceaccountSecret(context.Background(), "1", "2")
+	suite.NoError(err)
+	suite.Equal(expected, ret)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestCreateOrganizationServiceaccountSecretWithoutSecret() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/v2/orgs/1/service-accounts/2/secrets").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody([]byte(`{"id": "3", "created_at": "date"}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.CreateOrganizationServiceaccountSecret(context.Background(), "1", "2")
+	suite.Error(err)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestCreateOrganizationServiceaccountSecretUnknownField() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/v2/orgs/1/service-accounts/2/secrets").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody([]byte(`{"id": "3", "secret": "s11_orgsa_secret", "created_at": "date", "expires_at": null}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	ret, err := client.CreateOrganizationServiceaccountSecret(context.Background(), "1", "2")
+	suite.NoError(err)
+	suite.Equal("s11_orgsa_secret", ret.Secret)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientIAMTestSuite) TestCreateOrganizationServiceaccountSecretDecodeErrorHidesSecret() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/v2/orgs/1/service-accounts/2/secrets").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody([]byte(`{"id": 3, "secret": "s11_orgsa_secret", "created_at": "date", "expires_at": null}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	_, err := client.CreateOrganizationServiceaccountSecret(context.Background(), "1", "2")
+	suite.Error(err)
+	suite.NotContains(err.Error(), "s11_orgsa_secret")
+	mockServer.HasExpectedRequests()
+}
+
 func TestRestClientIAMTestSuite(t *testing.T) {
 	suite.Run(t, new(RestClientIAMTestSuite))
 }
@@ -0,0 +1,280 @@
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/rest"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/errors"
+)
+
+// keys of paginated responses that hold the items of the page
+var pageItemKeys = []string{"items", "results", "data"}
+
+// ListIterator walks over all items of a list endpoint page by page. The iam
+// service returns either plain JSON arrays, optionally paginated with a
+// "Link: <...>; rel=next" header, or envelopes that hold the items next to
+// a link or cursor to the next page or offset based paging information.
+//
+//	it := client.ListOrganizations(ctx, nil)
+//	for it.Next(ctx) {
+//		org := it.Value()
+//	}
+//	if it.Err() != nil { ... }
+type ListIterator[T any] struct {
+	client      *Client
+	next        string
+	errorFormat string
+
+	page    []T
+	index   int
+	visited map[string]bool
+	err     error
+}
+
+// newListIterator returns an iterator starting at path. The filters are sent as
+// query parameters, servers that do not support them return unfiltered lists.
+func newListIterator[T any](c *Client, path string, filters url.Values, errorFormat string) *ListIterator[T] {
+	if len(filters) > 0 {
+		path = path + "?" + filters.Encode()
+	}
+	return &ListIterator[T]{
+		client:      c,
+		next:        path,
+		errorFormat: errorFormat,
+		index:       -1,
+		visited:     map[string]bool{},
+	}
+}
+
+// Next advances to the next item and fetches the next page if required. It
+// returns false when all items have been visited or an error occurred.
+func (it *ListIterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for it.index+1 >= len(it.page) {
+		if it.next == "" {
+			return false
+		}
+		it.err = it.fetch(ctx)
+		if it.err != nil {
+			return false
+		}
+	}
+	it.index++
+	return true
+}
+
+// Value returns the current item
+func (it *ListIterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *ListIterator[T]) Err() error {
+	return it.err
+}
+
+// All collects the remaining items of all pages
+func (it *ListIterator[T]) All(ctx context.Context) ([]T, error) {
+	items := []T{}
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
+
+// findOne returns the only item that matches. The filters sent to the server
+// are only a hint, so the items are always checked on the client side as well.
+func findOne[T any](ctx context.Context, it *ListIterator[T], kind string, field string, value string, matches func(T) bool) (T, error) {
+	var found T
+	count := 0
+	for it.Next(ctx) {
+		if matches(it.Value()) {
+			found = it.Value()
+			count++
+		}
+	}
+	if it.Err() != nil {
+		return *new(T), it.Err()
+	}
+	switch count {
+	case 0:
+		return *new(T), errors.Trace(&NotFoundError{Kind: kind, Field: field, Value: value})
+	case 1:
+		return found, nil
+	default:
+		return *new(T), errors.Trace(&MultipleMatchesError{Kind: kind, Field: field, Value: value, Matches: count})
+	}
+}
+
+func (it *ListIterator[T]) fetch(ctx context.Context) error {
+	path := it.next
+	it.next = ""
+
+	response, err := it.client.client.NewRequest(http.MethodGet, path).Do(ctx)
+	if err != nil {
+		return errors.Trace(fmt.Errorf(it.errorFormat, err))
+	}
+	it.visited[response.Request.URL.String()] = true
+	err = it.client.checkResponse(response)
+	if err != nil {
+		return errors.Trace(fmt.Errorf(it.errorFormat, err))
+	}
+
+	body, err := response.ByteBody()
+	if err != nil {
+		return errors.Trace(fmt.Errorf(it.errorFormat, err))
+	}
+
+	var page []T
+	var next string
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		page, next, err = decodeEnvelope[T](trimmed, path)
+	} else {
+		page, err = decodeItems[T](body)
+	}
+	if err != nil {
+		return errors.Trace(fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, string(body)))
+	}
+	if next == "" {
+		next = nextLink(response)
+	}
+
+	it.page = page
+	it.index = -1
+	if next != "" {
+		next, err = resolveReference(response, next)
+		if err != nil {
+			return errors.Trace(fmt.Errorf(it.errorFormat, err))
+		}
+		// guard against servers that keep returning the same page
+		if !it.visited[next] {
+			it.next = next
+		}
+	}
+	return nil
+}
+
+// decodeEnvelope decodes a page that wraps its items in an object and
+// returns the path of the next page, if there is one
+func decodeEnvelope[T any](body []byte, path string) ([]T, string, error) {
+	var envelope map[string]json.RawMessage
+	err := json.Unmarshal(body, &envelope)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rawItems json.RawMessage
+	for _, key := range pageItemKeys {
+		if value, ok := envelope[key]; ok {
+			rawItems = value
+			break
+		}
+	}
+	if rawItems == nil {
+		return nil, "", fmt.Errorf("paginated response does not contain any of the keys %s", strings.Join(pageItemKeys, ", "))
+	}
+
+	page, err := decodeItems[T](rawItems)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if value, ok := envelope["next"]; ok && json.Unmarshal(value, &next) == nil && next != "" {
+		return page, next, nil
+	}
+	for _, key := range []string{"next_cursor", "cursor"} {
+		if value, ok := envelope[key]; ok && json.Unmarshal(value, &next) == nil && next != "" {
+			return page, withQueryParameter(path, "cursor", next), nil
+		}
+	}
+
+	var offset, limit, total int
+	if decodeInt(envelope, "offset", &offset) && decodeInt(envelope, "total", &total) {
+		if !decodeInt(envelope, "limit", &limit) {
+			limit = len(page)
+		}
+		if len(page) > 0 && offset+limit < total {
+			return page, withQueryParameter(withQueryParameter(path, "offset", strconv.Itoa(offset+limit)), "limit", strconv.Itoa(limit)), nil
+		}
+	}
+	return page, "", nil
+}
+
+// decodeItems is as strict as rest.Response.JSONUnmarshall, so that list
+// endpoints report changes of the API the same way the other calls do
+func decodeItems[T any](data []byte) ([]T, error) {
+	items := []T{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&items)
+	if err != nil {
+		return nil, err
+	}
+	err = validator.New().Var(items, "dive")
+	if err != nil {
+		return nil, fmt.Errorf("validation error: %v", err)
+	}
+	return items, nil
+}
+
+func decodeInt(envelope map[string]json.RawMessage, key string, v *int) bool {
+	value, ok := envelope[key]
+	return ok && json.Unmarshal(value, v) == nil
+}
+
+func withQueryParameter(path string, key string, value string) string {
+	u, err := url.Parse(path)
+	if err != nil {
+		return path
+	}
+	query := u.Query()
+	query.Set(key, value)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// nextLink returns the target of the "next" relation of the Link header
+func nextLink(response *rest.Response) string {
+	for _, header := range response.Header.Values("Link") {
+		for _, link := range strings.Split(header, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+			for _, param := range parts[1:] {
+				param = strings.ReplaceAll(strings.TrimSpace(param), `"`, "")
+				if strings.EqualFold(param, "rel=next") {
+					return target
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// resolveReference resolves links relative to the url of the response. Links
+// to other hosts are rejected, the credentials of the client must not be sent
+// anywhere else.
+func resolveReference(response *rest.Response, link string) (string, error) {
+	target, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid link to the next page %q: %w", link, err)
+	}
+	if response.Request == nil {
+		return link, nil
+	}
+	resolved := response.Request.URL.ResolveReference(target)
+	if resolved.Scheme != response.Request.URL.Scheme || resolved.Host != response.Request.URL.Host {
+		return "", fmt.Errorf("link to the next page %q points to a different host than %s", link, response.Request.URL.Redacted())
+	}
+	return resolved.String(), nil
+}
@@ -0,0 +1,90 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const IAMPermissionsEndpoint string = "/v2/permissions"
+
+const OrganizationPermissionScope string = "organization"
+const ProjectPermissionScope string = "project"
+
+type IAMPermission struct {
+	// permission identifier, e.g. can_become_administrator_in_project
+	Name string `json:"name" validate:"required"`
+
+	// permission scope, either organization or project
+	Scope string `json:"scope" validate:"required"`
+
+	// permission description
+	Description string `json:"description"`
+}
+
+// permissionCatalogRetryInterval is how long a failure to fetch the catalog
+// is remembered, so that a plan with many resources does not request the
+// catalog again for each of them
+const permissionCatalogRetryInterval = time.Minute
+
+// ErrPermissionCatalogUnavailable wraps the remembered error of an earlier
+// attempt to fetch the permission catalog
+var ErrPermissionCatalogUnavailable = errors.New("permission catalog is unavailable")
+
+// permissionCatalog holds the permissions of the iam service once they have
+// been fetched. The catalog only changes with deployments of the service, so
+// it is fetched successfully at most once per client.
+type permissionCatalog struct {
+	mu          sync.Mutex
+	loaded      bool
+	permissions []IAMPermission
+	err         error
+	retryAt     time.Time
+}
+
+func (c *Client) ListPermissions(ctx context.Context, filters url.Values) *ListIterator[IAMPermission] {
+	return newListIterator[IAMPermission](c, IAMPermissionsEndpoint, filters, GetPermissionsError)
+}
+
+// GetPermissionCatalog returns all permissions known to the iam service. A
+// successful result is reused by later calls. A failure is remembered for
+// permissionCatalogRetryInterval and returned wrapped in
+// ErrPermissionCatalogUnavailable until then.
+func (c *Client) GetPermissionCatalog(ctx context.Context) ([]IAMPermission, error) {
+	c.permissions.mu.Lock()
+	defer c.permissions.mu.Unlock()
+
+	if c.permissions.loaded {
+		return c.permissions.permissions, nil
+	}
+	if c.permissions.err != nil && time.Now().Before(c.permissions.retryAt) {
+		return nil, fmt.Errorf("%w: %w", ErrPermissionCatalogUnavailable, c.permissions.err)
+	}
+	permissions, err := c.ListPermissions(ctx, nil).All(ctx)
+	if err != nil {
+		// a cancelled caller says nothing about the availability of the catalog
+		if ctx.Err() == nil {
+			c.permissions.err = err
+			c.permissions.retryAt = time.Now().Add(permissionCatalogRetryInterval)
+		}
+		return nil, err
+	}
+	c.permissions.permissions = permissions
+	c.permissions.loaded = true
+	c.permissions.err = nil
+	return permissions, nil
+}
+
+// PermissionNames returns the names of the permissions with the given scope
+func PermissionNames(permissions []IAMPermission, scope string) []string {
+	names := []string{}
+	for _, permission := range permissions {
+		if permission.Scope == scope {
+			names = append(names, permission.Name)
+		}
+	}
+	return names
+}
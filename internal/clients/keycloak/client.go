@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,15 +12,24 @@ import (
 	"github.com/syseleven/terraform-provider-sys11iam/internal/logging"
 )
 
+// grant types supported by Authenticate
+const PasswordGrant string = "password"
+const ClientCredentialsGrant string = "client_credentials"
+
 type Client struct {
 	client *rest.Client
 	auth   struct {
+		grantType    string
 		username     string
 		password     string
 		clientId     string
 		clientSecret string
 		clientScope  string
 	}
+	// token endpoint and grant types found by Discover, an empty token
+	// endpoint posts to the client url itself
+	tokenEndpoint       string
+	grantTypesSupported []string
 }
 
 func NewClient(url string, timeout time.Duration) *Client {
@@ -31,9 +41,11 @@ func NewClient(url string, timeout time.Duration) *Client {
 	if strings.HasSuffix(url, "/api") {
 		url = url[:len(url)-4]
 	}
-	return &Client{
+	c := &Client{
 		client: rest.NewClient(url).WithTimeout(timeout),
 	}
+	c.auth.grantType = PasswordGrant
+	return c
 }
 
 func (c *Client) WithContext(ctx *rest.Context) *Client {
@@ -55,9 +67,19 @@ func (c *Client) WithClientConfig(clientId string, clientSecret string, clientSc
 	return c
 }
 
-func (c Client) Health() error {
+// WithClientCredentials configures the client_credentials grant, which
+// authenticates the client itself without a user
+func (c *Client) WithClientCredentials(clientId string, clientSecret string, clientScope string) *Client {
+	c.auth.grantType = ClientCredentialsGrant
+	c.auth.clientId = clientId
+	c.auth.clientSecret = clientSecret
+	c.auth.clientScope = clientScope
+	return c
+}
+
+func (c Client) Health(ctx context.Context) error {
 	// check for availability and auth by using
-	resp, err := c.client.NewRequest(http.MethodGet, "/").Do()
+	resp, err := c.client.NewRequest(http.MethodGet, "/").Do(ctx)
 	if err != nil {
 		return err
 	}
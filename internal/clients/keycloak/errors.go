@@ -6,3 +6,7 @@ const DeleteOrganizationError string = "could not delete organization: %s"
 
 const CreateProjectError string = "could not create project: %s"
 const DeleteProjectError string = "could not delete project: %s"
+
+const UnsupportedGrantTypeError string = "unsupported grant type: %s"
+const DiscoveryError string = "could not discover OIDC configuration: %s"
+const GrantTypeNotSupportedError string = "grant type %s is not supported by the OIDC provider, supported grant types: %s"
@@ -1,11 +1,17 @@
 package keycloak
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 )
 
+const WellKnownConfigurationEndpoint string = "/.well-known/openid-configuration"
+
 type AuthResponse struct {
 	// auth token
 	AuthToken         string `json:"access_token"`
@@ -18,25 +24,116 @@ type AuthResponse struct {
 	Scope             string `json:"scope,omitempty"`
 }
 
-func (c *Client) Login() (string, error) {
+// OpenIDConfiguration holds the parts of the OIDC discovery document the client uses
+type OpenIDConfiguration struct {
+	Issuer              string   `json:"issuer"`
+	TokenEndpoint       string   `json:"token_endpoint"`
+	GrantTypesSupported []string `json:"grant_types_supported"`
+}
+
+// Discover fetches the discovery document of the issuer the client was created
+// with. The token endpoint and the supported grant types are cached and used by
+// all later token requests.
+func (c *Client) Discover(ctx context.Context) (OpenIDConfiguration, error) {
+	response, err := c.client.NewRequest(http.MethodGet, WellKnownConfigurationEndpoint).Do(ctx)
+	if err != nil {
+		return OpenIDConfiguration{}, fmt.Errorf(DiscoveryError, err.Error())
+	}
+	err = c.checkResponse(response)
+	if err != nil {
+		return OpenIDConfiguration{}, fmt.Errorf(DiscoveryError, err.Error())
+	}
+
+	// the discovery document has many more fields than we are interested in
+	var configuration OpenIDConfiguration
+	err = response.JSONUnmarshall2(&configuration)
+	if err != nil {
+		return OpenIDConfiguration{}, fmt.Errorf(DiscoveryError, err.Error())
+	}
+	if configuration.TokenEndpoint == "" {
+		return OpenIDConfiguration{}, fmt.Errorf(DiscoveryError, errors.New("the discovery document does not contain a token_endpoint"))
+	}
+
+	c.tokenEndpoint = configuration.TokenEndpoint
+	c.grantTypesSupported = configuration.GrantTypesSupported
+	return configuration, nil
+}
+
+// checkGrantType fails for grant types the discovered provider does not
+// advertise, without discovery every grant type is attempted
+func (c *Client) checkGrantType(grantType string) error {
+	if len(c.grantTypesSupported) == 0 || slices.Contains(c.grantTypesSupported, grantType) {
+		return nil
+	}
+	return fmt.Errorf(GrantTypeNotSupportedError, grantType, strings.Join(c.grantTypesSupported, ", "))
+}
+
+// Login authenticates with the configured grant and returns the access token only.
+func (c *Client) Login(ctx context.Context) (string, error) {
+	authResponse, err := c.Authenticate(ctx)
+	if err != nil {
+		return "", err
+	}
+	return authResponse.AuthToken, nil
+}
+
+// Authenticate authenticates with the configured grant and returns the full
+// token response, including the refresh token and the lifetimes of both tokens.
+func (c *Client) Authenticate(ctx context.Context) (AuthResponse, error) {
+	formValues := make(url.Values, 0)
+	switch c.auth.grantType {
+	case PasswordGrant:
+		formValues.Add("grant_type", PasswordGrant)
+		formValues.Add("username", c.auth.username)
+		formValues.Add("password", c.auth.password)
+		formValues.Add("client_id", c.auth.clientId)
+		formValues.Add("client_secret", c.auth.clientSecret)
+		formValues.Add("scope", c.auth.clientScope)
+	case ClientCredentialsGrant:
+		formValues.Add("grant_type", ClientCredentialsGrant)
+		formValues.Add("client_id", c.auth.clientId)
+		formValues.Add("client_secret", c.auth.clientSecret)
+		if c.auth.clientScope != "" {
+			formValues.Add("scope", c.auth.clientScope)
+		}
+	default:
+		return AuthResponse{}, fmt.Errorf(UnsupportedGrantTypeError, c.auth.grantType)
+	}
+	err := c.checkGrantType(c.auth.grantType)
+	if err != nil {
+		return AuthResponse{}, err
+	}
+
+	return c.requestToken(ctx, formValues)
+}
+
+// Refresh exchanges a refresh token for a new token pair.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (AuthResponse, error) {
 	formValues := make(url.Values, 0)
-	formValues.Add("grant_type", "password")
-	formValues.Add("username", c.auth.username)
-	formValues.Add("password", c.auth.password)
+	err := c.checkGrantType("refresh_token")
+	if err != nil {
+		return AuthResponse{}, err
+	}
+
+	formValues.Add("grant_type", "refresh_token")
+	formValues.Add("refresh_token", refreshToken)
 	formValues.Add("client_id", c.auth.clientId)
 	formValues.Add("client_secret", c.auth.clientSecret)
-	formValues.Add("scope", c.auth.clientScope)
 
-	response, err := c.client.NewRequest(http.MethodPost, "").
+	return c.requestToken(ctx, formValues)
+}
+
+func (c *Client) requestToken(ctx context.Context, formValues url.Values) (AuthResponse, error) {
+	response, err := c.client.NewRequest(http.MethodPost, c.tokenEndpoint).
 		UseFormData(formValues).
-		Do()
+		Do(ctx)
 
 	if err != nil {
-		return "", err
+		return AuthResponse{}, err
 	}
 	err = c.checkResponse(response)
 	if err != nil {
-		return "", err
+		return AuthResponse{}, err
 	}
 	var authResponse AuthResponse
 	err = response.JSONUnmarshall(&authResponse)
@@ -45,8 +142,8 @@ func (c *Client) Login() (string, error) {
 		if respErr != nil {
 			body = "unable to parse body"
 		}
-		return "", fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body)
+		return AuthResponse{}, fmt.Errorf("%s (code: %d, body: %s)", err.Error(), response.StatusCode, body)
 	}
 
-	return authResponse.AuthToken, nil
+	return authResponse, nil
 }
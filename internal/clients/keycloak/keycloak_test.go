@@ -1,6 +1,7 @@
 package keycloak
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -26,7 +27,7 @@ func (suite *RestClientKeystoneTestSuite) TestLoginSuccess() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithClientConfig("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "pytest", "pytest", "pytest")
 
-	id, err := client.Login()
+	id, err := client.Login(context.Background())
 	suite.NoError(err)
 	suite.Equal(id, "token")
 	mockServer.HasExpectedRequests()
@@ -43,12 +44,173 @@ func (suite *RestClientKeystoneTestSuite) TestLoginError() {
 	defer mockServer.Close()
 	client := NewClient(mockServer.URL, 0).WithClientConfig("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "pytest", "pytest", "pytest")
 
-	id, err := client.Login()
+	id, err := client.Login(context.Background())
 	suite.Error(err) //TODO: check error message
 	suite.Equal(id, "")
 	mockServer.HasExpectedRequests()
 }
 
+func (suite *RestClientKeystoneTestSuite) TestLoginClientCredentialsSuccess() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=client_credentials`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "token", "expires_in": 300}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithClientCredentials("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "")
+
+	id, err := client.Login(context.Background())
+	suite.NoError(err)
+	suite.Equal(id, "token")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientKeystoneTestSuite) TestDiscoverSuccess() {
+	discovery := responses.Expect(http.MethodGet, "/realms/pytest/.well-known/openid-configuration").
+		ReturnWithCode(http.StatusOK)
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		discovery,
+		responses.Expect(http.MethodPost, "/realms/pytest/protocol/openid-connect/token").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=client_credentials`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "token"}`)),
+	)
+	defer mockServer.Close()
+	discovery.ReturnWithBody([]byte(`{
+		"issuer": "` + mockServer.URL + `/realms/pytest",
+		"token_endpoint": "` + mockServer.URL + `/realms/pytest/protocol/openid-connect/token",
+		"grant_types_supported": ["password", "client_credentials", "refresh_token"],
+		"jwks_uri": "` + mockServer.URL + `/realms/pytest/protocol/openid-connect/certs"
+	}`))
+	client := NewClient(mockServer.URL+"/realms/pytest", 0).WithClientCredentials("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "")
+
+	configuration, err := client.Discover(context.Background())
+	suite.NoError(err)
+	suite.Equal(mockServer.URL+"/realms/pytest/protocol/openid-connect/token", configuration.TokenEndpoint)
+
+	id, err := client.Login(context.Background())
+	suite.NoError(err)
+	suite.Equal(id, "token")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientKeystoneTestSuite) TestDiscoverUnsupportedGrantType() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodGet, "/realms/pytest/.well-known/openid-configuration").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{
+				"token_endpoint": "http://localhost/realms/pytest/protocol/openid-connect/token",
+				"grant_types_supported": ["authorization_code", "refresh_token"]
+			}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL+"/realms/pytest", 0).WithClientCredentials("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "")
+
+	_, err := client.Discover(context.Background())
+	suite.NoError(err)
+
+	id, err := client.Login(context.Background())
+	suite.EqualError(err, "grant type client_credentials is not supported by the OIDC provider, supported grant types: authorization_code, refresh_token")
+	suite.Equal(id, "")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientKeystoneTestSuite) TestDiscoverMissingTokenEndpoint() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodGet, "/.well-known/openid-configuration").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"issuer": "http://localhost"}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0)
+
+	_, err := client.Discover(context.Background())
+	suite.EqualError(err, "could not discover OIDC configuration: the discovery document does not contain a token_endpoint")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientKeystoneTestSuite) TestTokenSourceCachesToken() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=password&password=pytest&scope=pytest&username=pytest`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "token", "refresh_token": "refresh", "expires_in": 300, "refresh_expires_in": 1800}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithClientConfig("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "pytest", "pytest", "pytest")
+	tokenSource := NewTokenSource(client)
+
+	for range []int{1, 2} {
+		token, err := tokenSource.Token(context.Background())
+		suite.NoError(err)
+		suite.Equal("token", token)
+	}
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientKeystoneTestSuite) TestTokenSourceRefresh() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=password&password=pytest&scope=pytest&username=pytest`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "token", "refresh_token": "refresh", "expires_in": 1, "refresh_expires_in": 1800}`)),
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=refresh_token&refresh_token=refresh`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "refreshed-token", "refresh_token": "refresh2", "expires_in": 300, "refresh_expires_in": 1800}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithClientConfig("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "pytest", "pytest", "pytest")
+	tokenSource := NewTokenSource(client)
+
+	token, err := tokenSource.Token(context.Background())
+	suite.NoError(err)
+	suite.Equal("token", token)
+
+	// the first token expires within the expiry delta and is refreshed right away
+	token, err = tokenSource.Token(context.Background())
+	suite.NoError(err)
+	suite.Equal("refreshed-token", token)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *RestClientKeystoneTestSuite) TestTokenSourceRefreshFallsBackToLogin() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=password&password=pytest&scope=pytest&username=pytest`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "token", "refresh_token": "refresh", "expires_in": 1, "refresh_expires_in": 1800}`)),
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=refresh_token&refresh_token=refresh`)).
+			ReturnWithCode(http.StatusBadRequest).
+			ReturnWithBody([]byte(`{"error": "invalid_grant"}`)),
+		responses.Expect(http.MethodPost, "/").
+			WithBody([]byte(`client_id=pytest&client_secret=YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK&grant_type=password&password=pytest&scope=pytest&username=pytest`)).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"access_token": "new-token", "expires_in": 300}`)),
+	)
+	defer mockServer.Close()
+	client := NewClient(mockServer.URL, 0).WithClientConfig("pytest", "YKjKvRHYtGjbxjsU2auNzcvt4FOaH5SK", "pytest", "pytest", "pytest")
+	tokenSource := NewTokenSource(client)
+
+	token, err := tokenSource.Token(context.Background())
+	suite.NoError(err)
+	suite.Equal("token", token)
+
+	token, err = tokenSource.Token(context.Background())
+	suite.NoError(err)
+	suite.Equal("new-token", token)
+	mockServer.HasExpectedRequests()
+}
+
 func TestRestClientKeystoneTestSuite(t *testing.T) {
 	suite.Run(t, new(RestClientKeystoneTestSuite))
 }
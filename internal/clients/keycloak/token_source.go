@@ -0,0 +1,81 @@
+package keycloak
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tokens are renewed this long before they actually expire, so that a token
+// handed out for a request does not run out while the request is in flight
+const tokenExpiryDelta = 30 * time.Second
+
+// TokenSource hands out access tokens and renews them before they expire.
+// It first tries the refresh_token grant and falls back to a full login when
+// no usable refresh token is available or the refresh fails.
+type TokenSource struct {
+	client *Client
+
+	mu            sync.Mutex
+	accessToken   string
+	refreshToken  string
+	expiry        time.Time
+	refreshExpiry time.Time
+}
+
+func NewTokenSource(client *Client) *TokenSource {
+	return &TokenSource{
+		client: client,
+	}
+}
+
+// Token returns a valid access token, renewing it if required.
+func (ts *TokenSource) Token(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	now := time.Now()
+	if ts.accessToken != "" && isValid(ts.expiry, now) {
+		return ts.accessToken, nil
+	}
+
+	if ts.refreshToken != "" && isValid(ts.refreshExpiry, now) {
+		authResponse, err := ts.client.Refresh(ctx, ts.refreshToken)
+		if err == nil {
+			ts.update(authResponse, now)
+			return ts.accessToken, nil
+		}
+		tflog.Debug(ctx, "Refreshing access token failed, logging in again.", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	authResponse, err := ts.client.Authenticate(ctx)
+	if err != nil {
+		return "", err
+	}
+	ts.update(authResponse, now)
+	return ts.accessToken, nil
+}
+
+func (ts *TokenSource) update(authResponse AuthResponse, now time.Time) {
+	ts.accessToken = authResponse.AuthToken
+	ts.refreshToken = authResponse.RefreshToken
+	ts.expiry = expiresAt(now, authResponse.ExpiresIn)
+	ts.refreshExpiry = expiresAt(now, authResponse.RefreshExpires_in)
+}
+
+// expiresAt returns the zero time for lifetimes of zero, which keycloak uses
+// for tokens that do not expire
+func expiresAt(now time.Time, lifetime int) time.Time {
+	if lifetime <= 0 {
+		return time.Time{}
+	}
+	return now.Add(time.Duration(lifetime)*time.Second - tokenExpiryDelta)
+}
+
+func isValid(expiry time.Time, now time.Time) bool {
+	return expiry.IsZero() || now.Before(expiry)
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
 	"time"
@@ -9,6 +10,12 @@ import (
 const RequestIDHeader = "X-Request-Id"
 const AuthorizationHeader = "Authorization"
 
+// TokenSource supplies bearer tokens on demand, so that expiring tokens can
+// be renewed between requests
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
 type Client struct {
 	url  string
 	auth struct {
@@ -20,8 +27,12 @@ type Client struct {
 		clientId            string
 		clientSecret        string
 		clientScope         string
+		tokenSource         TokenSource
 	}
 	requestID      string
+	retry          retryConfig
+	limiter        *rateLimiter
+	inFlight       chan struct{}
 	defaultHeaders map[string]string
 	client         *http.Client
 }
@@ -42,6 +53,39 @@ func (c *Client) WithTimeout(timeout time.Duration) *Client {
 	return c
 }
 
+// WithRetry retries failed requests up to maxAttempts times in total, waiting
+// between baseDelay and maxDelay before each retry. Only idempotent requests
+// are retried after network errors and gateway errors, rate limited requests
+// are retried regardless of their method.
+func (c *Client) WithRetry(maxAttempts int, baseDelay time.Duration, maxDelay time.Duration) *Client {
+	c.retry = retryConfig{
+		maxAttempts: maxAttempts,
+		baseDelay:   baseDelay,
+		maxDelay:    maxDelay,
+	}
+	return c
+}
+
+// WithRateLimit limits the client to requestsPerSecond requests on average,
+// allowing bursts of up to burst requests. Retries count as requests.
+func (c *Client) WithRateLimit(requestsPerSecond float64, burst int) *Client {
+	c.limiter = nil
+	if requestsPerSecond > 0 {
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+	return c
+}
+
+// WithMaxConcurrentRequests limits the number of requests in flight at the same
+// time, zero allows any number of requests
+func (c *Client) WithMaxConcurrentRequests(maxConcurrentRequests int) *Client {
+	c.inFlight = nil
+	if maxConcurrentRequests > 0 {
+		c.inFlight = make(chan struct{}, maxConcurrentRequests)
+	}
+	return c
+}
+
 func (c *Client) SkipSSLVerify() *Client {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
@@ -68,6 +112,13 @@ func (c *Client) WithBearerToken(token string) *Client {
 	return c
 }
 
+// WithTokenSource consults the token source before every request and takes
+// precedence over a static bearer token
+func (c *Client) WithTokenSource(tokenSource TokenSource) *Client {
+	c.auth.tokenSource = tokenSource
+	return c
+}
+
 func (c *Client) WithRequestID(requestID string) *Client {
 	c.requestID = requestID
 	return c
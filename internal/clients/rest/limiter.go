@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// rateLimiter is a token bucket that refills at rate tokens per second and
+// holds up to burst tokens
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait until the
+// token is actually available. Tokens may go negative, so that concurrent
+// callers queue up behind each other instead of all waking at once.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// acquire blocks until the request may be sent according to the rate limit and
+// the number of requests in flight, or until the context is done. Every
+// successful call has to be followed by release.
+func (c *Client) acquire(ctx context.Context, method string, url string) error {
+	fields := map[string]interface{}{
+		"method": method,
+		"url":    url,
+	}
+	if c.limiter != nil {
+		if delay := c.limiter.reserve(time.Now()); delay > 0 {
+			fields["delay"] = delay.String()
+			tflog.Debug(ctx, "Rate limit reached, delaying request.", fields)
+			err := sleep(ctx, delay)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if c.inFlight != nil {
+		select {
+		case c.inFlight <- struct{}{}:
+		default:
+			fields["max_concurrent_requests"] = cap(c.inFlight)
+			tflog.Debug(ctx, "Too many requests in flight, waiting for a free slot.", fields)
+			select {
+			case c.inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) release() {
+	if c.inFlight != nil {
+		<-c.inFlight
+	}
+}
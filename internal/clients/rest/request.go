@@ -2,12 +2,16 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Request struct {
@@ -34,8 +38,17 @@ func (req *Request) AddHeader(key, value string) *Request {
 	return req
 }
 
-func (req *Request) Do() (resp *Response, err error) {
-	request, err := http.NewRequest(req.method, req.client.url+req.path, nil)
+// url resolves the request path against the client url, absolute urls
+// are used as they are
+func (req *Request) url() string {
+	if strings.HasPrefix(req.path, "http://") || strings.HasPrefix(req.path, "https://") {
+		return req.path
+	}
+	return req.client.url + req.path
+}
+
+func (req *Request) Do(ctx context.Context) (resp *Response, err error) {
+	request, err := http.NewRequestWithContext(ctx, req.method, req.url(), nil)
 	if err != nil {
 		return
 	}
@@ -74,24 +87,105 @@ func (req *Request) Do() (resp *Response, err error) {
 		request.Header.Add("X-Request-Id", req.client.requestID)
 	}
 
-	if len(req.client.auth.bearerToken) > 0 {
-		request.Header.Add("Authorization", "Bearer "+req.client.auth.bearerToken)
-	}
 	if len(req.client.auth.xAuthToken) > 0 {
 		request.Header.Add("X-Auth-Token", req.client.auth.xAuthToken)
 	}
-	if len(req.client.auth.username) > 0 {
-		request.SetBasicAuth(req.client.auth.username, req.client.auth.password)
-	}
 
 	for k, v := range req.headers {
 		request.Header.Add(k, v)
 	}
 
-	response, err := req.client.client.Do(request)
-	if err != nil {
-		return
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && request.GetBody != nil {
+			request.Body, err = request.GetBody()
+			if err != nil {
+				return
+			}
+		}
+
+		// the token may have expired while waiting for a retry, so it is
+		// resolved again for every attempt
+		err = req.setAuthorization(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		err = req.client.acquire(ctx, req.method, req.url())
+		if err != nil {
+			return nil, err
+		}
+		response, err := req.client.client.Do(request)
+		if attempt >= req.client.retry.maxAttempts || ctx.Err() != nil || !shouldRetry(req.method, response, err) {
+			if err != nil {
+				req.client.release()
+				return nil, err
+			}
+			// the request counts as in flight until its body has been read
+			resp := newResponse(response, requestBody)
+			err = resp.bufferBody()
+			req.client.release()
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		delay := req.client.retry.delay(attempt, response)
+		fields := map[string]interface{}{
+			"method":  req.method,
+			"url":     request.URL.String(),
+			"attempt": attempt,
+			"delay":   delay.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+			tflog.Debug(ctx, "Request failed, retrying.", fields)
+		} else {
+			fields["status_code"] = response.StatusCode
+			tflog.Debug(ctx, "Request returned a retryable status code, retrying.", fields)
+			// the connection can only be reused once the body has been consumed
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+		req.client.release()
+		err = sleep(ctx, delay)
+		if err != nil {
+			return nil, err
+		}
 	}
+}
 
-	return newResponse(response, requestBody), nil
+// setAuthorization sets the Authorization header of the request. Basic
+// authentication takes precedence over a bearer token.
+func (req *Request) setAuthorization(ctx context.Context, request *http.Request) error {
+	bearerToken := req.client.auth.bearerToken
+	if req.client.auth.tokenSource != nil {
+		var err error
+		bearerToken, err = req.client.auth.tokenSource.Token(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(bearerToken) > 0 {
+		request.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+	if len(req.client.auth.username) > 0 {
+		request.SetBasicAuth(req.client.auth.username, req.client.auth.password)
+	}
+	return nil
+}
+
+// sleep waits for the delay to pass or the context to be done, whichever comes first
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
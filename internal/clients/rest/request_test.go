@@ -1,9 +1,14 @@
 package rest
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -25,10 +30,256 @@ func (suite *RequestTestSuite) TestDuplicateAuthenticationHeaders() {
 		"some-request-id",
 		"some-auth-token",
 	}
-	_, err := c.UseContext(&ctx).NewRequest(http.MethodGet, "/foo").Do()
+	_, err := c.UseContext(&ctx).NewRequest(http.MethodGet, "/foo").Do(context.Background())
 	suite.NoError(err)
 }
 
+func (suite *RequestTestSuite) TestAbsoluteURL() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/token", r.URL.Path)
+	}))
+	defer mockServer.Close()
+
+	c := NewClient("http://localhost/unused")
+	_, err := c.NewRequest(http.MethodPost, mockServer.URL+"/token").Do(context.Background())
+	suite.NoError(err)
+}
+
+func (suite *RequestTestSuite) TestRetryIdempotentRequest() {
+	codes := []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}
+	calls := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		suite.NoError(err)
+		suite.Equal(`{"foo":"bar"}`, string(body))
+		w.WriteHeader(codes[calls])
+		calls++
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).WithRetry(3, time.Millisecond, 10*time.Millisecond)
+	resp, err := c.NewRequest(http.MethodPut, "/foo").UseJSONPayload([]byte(`{"foo":"bar"}`)).Do(context.Background())
+	suite.NoError(err)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	suite.Equal(3, calls)
+}
+
+func (suite *RequestTestSuite) TestRetryGivesUpAfterMaxAttempts() {
+	calls := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGatewayTimeout)
+		calls++
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).WithRetry(2, time.Millisecond, 10*time.Millisecond)
+	resp, err := c.NewRequest(http.MethodGet, "/foo").Do(context.Background())
+	suite.NoError(err)
+	suite.Equal(http.StatusGatewayTimeout, resp.StatusCode)
+	suite.Equal(2, calls)
+}
+
+func (suite *RequestTestSuite) TestNoRetryForNonIdempotentRequest() {
+	calls := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		calls++
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).WithRetry(3, time.Millisecond, 10*time.Millisecond)
+	resp, err := c.NewRequest(http.MethodPost, "/foo").Do(context.Background())
+	suite.NoError(err)
+	suite.Equal(http.StatusBadGateway, resp.StatusCode)
+	suite.Equal(1, calls)
+}
+
+func (suite *RequestTestSuite) TestRetryRateLimitedRequest() {
+	calls := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls == 0 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+		calls++
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).WithRetry(3, time.Hour, time.Hour)
+	resp, err := c.NewRequest(http.MethodPost, "/foo").Do(context.Background())
+	suite.NoError(err)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	suite.Equal(2, calls)
+}
+
+func (suite *RequestTestSuite) TestRetryDelay() {
+	rc := retryConfig{maxAttempts: 10, baseDelay: time.Second, maxDelay: 5 * time.Second}
+	for attempt, backoff := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 40: 5 * time.Second} {
+		delay := rc.delay(attempt, nil)
+		suite.GreaterOrEqual(delay, backoff/2)
+		suite.LessOrEqual(delay, backoff)
+	}
+
+	response := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": []string{"3"}}}
+	suite.Equal(3*time.Second, rc.delay(1, response))
+	response.Header.Set("Retry-After", "60")
+	suite.Equal(5*time.Second, rc.delay(1, response))
+}
+
+func (suite *RequestTestSuite) TestRetryDelayDoesNotOverflow() {
+	rc := retryConfig{maxAttempts: 100, baseDelay: 10 * time.Second, maxDelay: time.Hour}
+	for attempt := 1; attempt <= rc.maxAttempts; attempt++ {
+		delay := rc.delay(attempt, nil)
+		suite.Greater(delay, time.Duration(0), "attempt %d", attempt)
+		suite.LessOrEqual(delay, rc.maxDelay, "attempt %d", attempt)
+	}
+}
+
+func (suite *RequestTestSuite) TestRateLimiter() {
+	now := time.Now()
+	limiter := newRateLimiter(2, 2)
+	limiter.last = now
+
+	// the burst is available right away
+	suite.Equal(time.Duration(0), limiter.reserve(now))
+	suite.Equal(time.Duration(0), limiter.reserve(now))
+	// further requests queue up behind each other
+	suite.Equal(500*time.Millisecond, limiter.reserve(now))
+	suite.Equal(time.Second, limiter.reserve(now))
+	// tokens refill over time
+	suite.Equal(500*time.Millisecond, limiter.reserve(now.Add(time.Second)))
+}
+
+func (suite *RequestTestSuite) TestMaxConcurrentRequests() {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).WithMaxConcurrentRequests(2)
+	var wg sync.WaitGroup
+	for range []int{1, 2, 3, 4, 5, 6} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.NewRequest(http.MethodGet, "/foo").Do(context.Background())
+			suite.NoError(err)
+		}()
+	}
+	wg.Wait()
+	suite.Equal(2, maxInFlight)
+}
+
+func (suite *RequestTestSuite) TestRequestIsInFlightUntilBodyIsRead() {
+	started, unblock := make(chan struct{}), make(chan struct{})
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		close(started)
+		<-unblock
+		_, _ = w.Write([]byte("done"))
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).WithMaxConcurrentRequests(1)
+	done := make(chan *Response)
+	go func() {
+		resp, err := c.NewRequest(http.MethodGet, "/foo").Do(context.Background())
+		suite.NoError(err)
+		done <- resp
+	}()
+
+	// the headers have been sent, but the body has not
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	suite.ErrorIs(c.acquire(ctx, http.MethodGet, "/bar"), context.DeadlineExceeded)
+
+	close(unblock)
+	resp := <-done
+	body, err := resp.StringBody()
+	suite.NoError(err)
+	suite.Equal("done", body)
+	suite.NoError(c.acquire(context.Background(), http.MethodGet, "/bar"))
+	c.release()
+}
+
+func (suite *RequestTestSuite) TestRetryStopsWhenContextIsDone() {
+	calls := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		calls++
+	}))
+	defer mockServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c := NewClient(mockServer.URL).WithRetry(3, time.Hour, time.Hour)
+	_, err := c.NewRequest(http.MethodGet, "/foo").Do(ctx)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.Equal(1, calls)
+}
+
+type staticTokenSource string
+
+func (ts staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(ts), nil
+}
+
+func (suite *RequestTestSuite) TestTokenSource() {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Len(r.Header["Authorization"], 1)
+		suite.Equal("Bearer fresh-token", r.Header.Get("Authorization"))
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).
+		WithBearerToken("stale-token").
+		WithTokenSource(staticTokenSource("fresh-token"))
+	_, err := c.NewRequest(http.MethodGet, "/foo").Do(context.Background())
+	suite.NoError(err)
+}
+
+// countingTokenSource returns a new token on every call
+type countingTokenSource struct {
+	calls int
+}
+
+func (ts *countingTokenSource) Token(ctx context.Context) (string, error) {
+	ts.calls++
+	return fmt.Sprintf("token-%d", ts.calls), nil
+}
+
+func (suite *RequestTestSuite) TestTokenSourceIsUsedForEveryAttempt() {
+	calls := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		suite.Len(r.Header["Authorization"], 1)
+		suite.Equal(fmt.Sprintf("Bearer token-%d", calls), r.Header.Get("Authorization"))
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer mockServer.Close()
+
+	c := NewClient(mockServer.URL).
+		WithRetry(2, time.Millisecond, 10*time.Millisecond).
+		WithTokenSource(&countingTokenSource{})
+	resp, err := c.NewRequest(http.MethodGet, "/foo").Do(context.Background())
+	suite.NoError(err)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	suite.Equal(2, calls)
+}
+
 func TestRequestTestSuite(t *testing.T) {
 	suite.Run(t, new(RequestTestSuite))
 }
@@ -92,6 +92,17 @@ func (resp *Response) readBody() error {
 	return resp.Body.Close()
 }
 
+// bufferBody reads the whole body into memory and closes the underlying
+// connection body. The response can still be read and decoded afterwards.
+func (resp *Response) bufferBody() error {
+	err := resp.readBody()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(*resp.byteBody))
+	return nil
+}
+
 func (resp *Response) DebugInfo() *DebugInfo {
 	var debugInfo DebugInfo
 
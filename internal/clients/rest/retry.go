@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryConfig controls how often and how long Request.Do retries, a
+// maxAttempts of one or less disables retries
+type retryConfig struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+// isIdempotent reports whether a request with the method can safely be sent
+// again after a network error or a gateway error, which might have hit the
+// backend already
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(method string, response *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		// rate limited requests have not been processed
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// delay returns the time to wait before the next attempt. A Retry-After header
+// sent with 429 and 503 responses is honoured, otherwise the delay grows
+// exponentially with jitter. Both are capped at maxDelay.
+func (rc retryConfig) delay(attempt int, response *http.Response) time.Duration {
+	if response != nil && (response.StatusCode == http.StatusTooManyRequests || response.StatusCode == http.StatusServiceUnavailable) {
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			return min(retryAfter, rc.maxDelay)
+		}
+	}
+
+	// shifting maxDelay down instead of baseDelay up cannot overflow
+	backoff := rc.maxDelay
+	if rc.maxDelay>>(attempt-1) > rc.baseDelay {
+		backoff = rc.baseDelay << (attempt - 1)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// wait at least half of the backoff, the other half is random so that
+	// parallel requests do not retry in lockstep
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// parseRetryAfter supports both the delay-seconds and the HTTP-date format
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
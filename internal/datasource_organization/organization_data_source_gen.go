@@ -23,9 +23,10 @@ func OrganizationDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "A description for the organization.",
 			},
 			"id": schema.StringAttribute{
-				Required:            true,
-				Description:         "The UUID of the organization",
-				MarkdownDescription: "The UUID of the organization",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The UUID of the organization. At least one of id and name has to be set.",
+				MarkdownDescription: "The UUID of the organization. At least one of `id` and `name` has to be set.",
 			},
 			"is_active": schema.BoolAttribute{
 				Computed:            true,
@@ -33,9 +34,10 @@ func OrganizationDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Whether the organization is active or not.",
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
-				Description:         "A unique name for the organization.",
-				MarkdownDescription: "A unique name for the organization.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "A unique name for the organization. At least one of id and name has to be set.",
+				MarkdownDescription: "A unique name for the organization. At least one of `id` and `name` has to be set.",
 			},
 			"tags": schema.ListAttribute{
 				Computed:            true,
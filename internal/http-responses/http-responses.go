@@ -152,14 +152,14 @@ func (ms *MockServer) handlerFunc() http.Handler {
 			ms.suite.Equal(*expectation.request.body, bodyBytes, requestNumber)
 		}
 
-		if expectation.response.code > 0 {
-			w.WriteHeader(expectation.response.code)
-		}
-
 		for key, value := range expectation.response.headers {
 			w.Header().Add(key, value)
 		}
 
+		if expectation.response.code > 0 {
+			w.WriteHeader(expectation.response.code)
+		}
+
 		if len(expectation.response.body) > 0 {
 			w.Write(expectation.response.body)
 		}
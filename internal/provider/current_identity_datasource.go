@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_current_identity"
+)
+
+var (
+	_ datasource.DataSource              = &currentIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentIdentityDataSource{}
+)
+
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &currentIdentityDataSource{}
+}
+
+type currentIdentityDataSource struct {
+	client *iam.Client
+}
+
+func (r *currentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+func (r *currentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_current_identity.CurrentIdentityDataSourceSchema(ctx)
+}
+
+func (r *currentIdentityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *currentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_current_identity.CurrentIdentityModel
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading current identity datasource.")
+	response, err := r.client.GetCurrentIdentity(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	permissions := []string{}
+	organizations := []datasource_current_identity.OrganizationModel{}
+	for _, organization := range response.Organizations {
+		for _, permission := range organization.Permissions {
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+
+		sort.Sort(sort.StringSlice(organization.Permissions))
+		organizationPermissions, diags := types.ListValueFrom(ctx, types.StringType, organization.Permissions)
+		resp.Diagnostics.Append(diags...)
+		organizations = append(organizations, datasource_current_identity.OrganizationModel{
+			Id:          types.StringValue(organization.ID),
+			Name:        types.StringValue(organization.Name),
+			Permissions: organizationPermissions,
+		})
+	}
+	sort.Sort(sort.StringSlice(permissions))
+	sort.Slice(organizations, func(i, j int) bool {
+		return organizations[i].Name.ValueString() < organizations[j].Name.ValueString()
+	})
+
+	data.Type = types.StringValue(response.Type)
+	data.Id = types.StringValue(response.ID)
+	data.Name = types.StringValue(response.Name)
+	data.Email = stringOrNull(response.Email)
+	var diags diag.Diagnostics
+	data.Permissions, diags = types.ListValueFrom(ctx, types.StringType, permissions)
+	resp.Diagnostics.Append(diags...)
+	data.Organizations, diags = types.ListValueFrom(ctx, datasource_current_identity.OrganizationType, organizations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
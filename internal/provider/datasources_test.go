@@ -0,0 +1,639 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/suite"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_current_identity"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_contacts"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_invitations"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_members"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_team_members"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_teams"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organizations"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_permissions"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_project_members"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_project_s3users"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_projects"
+	responses "github.com/syseleven/terraform-provider-sys11iam/internal/http-responses"
+)
+
+type DataSourceTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DataSourceTestSuite) marshal(v interface{}) []byte {
+	body, err := json.Marshal(v)
+	suite.NoError(err)
+	return body
+}
+
+// newConfig returns a configuration of the data source with the given top
+// level attributes set
+func (suite *DataSourceTestSuite) newConfig(d datasource.DataSource, attributes map[string]interface{}) tfsdk.Config {
+	ctx := context.Background()
+	schemaResponse := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+	suite.False(schemaResponse.Diagnostics.HasError(), schemaResponse.Diagnostics)
+
+	// tfsdk.Config can not be written, so the values are assembled as a state
+	state := tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    nullObject(schemaResponse.Schema.Type().TerraformType(ctx)),
+	}
+	for name, value := range attributes {
+		diags := state.SetAttribute(ctx, path.Root(name), value)
+		suite.False(diags.HasError(), diags)
+	}
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// readDataSource configures the data source with a client for the mock server
+// and runs Read on the given configuration
+func (suite *DataSourceTestSuite) readDataSource(mockServer *responses.MockServer, d datasource.DataSource, config tfsdk.Config) datasource.ReadResponse {
+	ctx := context.Background()
+	client := iam.NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	configureResponse := datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &configureResponse)
+	suite.False(configureResponse.Diagnostics.HasError(), configureResponse.Diagnostics)
+
+	readResponse := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &readResponse)
+	return readResponse
+}
+
+func (suite *DataSourceTestSuite) assertDataSourceAttribute(readResponse datasource.ReadResponse, name string, expected interface{}) {
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	actual := reflect.New(reflect.TypeOf(expected))
+	diags := readResponse.State.GetAttribute(context.Background(), path.Root(name), actual.Interface())
+	suite.False(diags.HasError(), diags)
+	suite.Equal(expected, actual.Elem().Interface())
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationReadById() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"id": "1"})
+	suite.assertDataSourceAttribute(suite.readDataSource(mockServer, d, config), "name", "sample-org")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationReadByName() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			WithQueryParameters(map[string]string{"name": "sample-org"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganization{exampleOrganization})),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"name": "sample-org"})
+	suite.assertDataSourceAttribute(suite.readDataSource(mockServer, d, config), "id", "1")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationReadByNameNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"name": "sample-org"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.True(readResponse.Diagnostics.HasError())
+	suite.Contains(readResponse.Diagnostics.Errors()[0].Detail(), `organization with name "sample-org" was not found`)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationReadNameMismatch() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"id": "1", "name": "other-org"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.True(readResponse.Diagnostics.HasError())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationsRead() {
+	inactiveOrganization := exampleOrganization
+	inactiveOrganization.ID = "2"
+	inactiveOrganization.Name = "inactive-org"
+	inactiveOrganization.IsActive = false
+	otherOrganization := exampleOrganization
+	otherOrganization.ID = "3"
+	otherOrganization.Name = "other-org"
+	otherOrganization.Tags = []string{"other-tag"}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganization{otherOrganization, inactiveOrganization, exampleOrganization})),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var organizations []datasource_organizations.OrganizationModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("organizations"), &organizations)
+	suite.Len(organizations, 3)
+	suite.Equal("inactive-org", organizations[0].Name.ValueString())
+	suite.Equal("other-org", organizations[1].Name.ValueString())
+	suite.Equal("sample-org", organizations[2].Name.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationsReadFiltered() {
+	inactiveOrganization := exampleOrganization
+	inactiveOrganization.ID = "2"
+	inactiveOrganization.Name = "sample-inactive-org"
+	inactiveOrganization.IsActive = false
+	otherOrganization := exampleOrganization
+	otherOrganization.ID = "3"
+	otherOrganization.Name = "sample-other-org"
+	otherOrganization.Tags = []string{"other-tag"}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganization{otherOrganization, inactiveOrganization, exampleOrganization, {ID: "4", Name: "unrelated", Tags: []string{"sample-tag"}, CreatedAt: "date", UpdatedAt: "date", IsActive: true}})),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"name_regex": "^sample-", "tag": "sample-tag", "is_active": true})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var organizations []datasource_organizations.OrganizationModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("organizations"), &organizations)
+	suite.Len(organizations, 1)
+	suite.Equal("1", organizations[0].Id.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationsReadInvalidRegex() {
+	mockServer := responses.NewMockServer(&suite.Suite)
+	defer mockServer.Close()
+
+	d := NewOrganizationsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"name_regex": "("})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.True(readResponse.Diagnostics.HasError())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestProjectReadById() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects/2").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleProject)),
+	)
+	defer mockServer.Close()
+
+	d := NewProjectDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "id": "2"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.assertDataSourceAttribute(readResponse, "name", "sample-project")
+	suite.assertDataSourceAttribute(readResponse, "status", "active")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestProjectReadByName() {
+	otherProject := exampleProject
+	otherProject.ID = "3"
+	otherProject.Name = "other-project"
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects").
+			WithQueryParameters(map[string]string{"name": "sample-project"}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMProject{otherProject, exampleProject})),
+	)
+	defer mockServer.Close()
+
+	d := NewProjectDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "name": "sample-project"})
+	suite.assertDataSourceAttribute(suite.readDataSource(mockServer, d, config), "id", "2")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestProjectReadByNameNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	d := NewProjectDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "name": "sample-project"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.True(readResponse.Diagnostics.HasError())
+	suite.Contains(readResponse.Diagnostics.Errors()[0].Detail(), `project with name "sample-project" was not found`)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestProjectsReadFiltered() {
+	pendingProject := exampleProject
+	pendingProject.ID = "3"
+	pendingProject.Name = "pending-project"
+	pendingProject.Status = "pending"
+	otherProject := exampleProject
+	otherProject.ID = "4"
+	otherProject.Name = "other-project"
+	otherProject.Tags = []string{"other-tag"}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMProject{pendingProject, otherProject, exampleProject})),
+	)
+	defer mockServer.Close()
+
+	d := NewProjectsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "tag": "sample-tag", "status": "active"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var projects []datasource_projects.ProjectModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("projects"), &projects)
+	suite.Len(projects, 1)
+	suite.Equal("2", projects[0].Id.ValueString())
+	suite.Equal("date", projects[0].CreatedAt.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationMembersRead() {
+	memberships := []iam.IAMOrganizationMembership{
+		{ID: "3", Organisation: exampleOrganization, MembershipType: "service_account", ServiceAccount: iam.IAMOrganisationServiceAccount{ID: "4", Name: "ci"}, Permissions: []string{"can_do"}},
+		{ID: "2", Organisation: exampleOrganization, MembershipType: "user", Affiliation: "owner", User: exampleUser, ImmutablePermissions: []string{"will_do", "can_do"}},
+		{ID: "1", Organisation: exampleOrganization, MembershipType: "user", Affiliation: "member", User: iam.IAMOrganisationUser{ID: "5", Email: "other@syseleven.net"}, Permissions: []string{"wont_do"}},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(memberships)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationMembersDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "permission": "can_do"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var members []datasource_organization_members.OrganizationMemberModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("members"), &members)
+	suite.Len(members, 2)
+	suite.Equal("3", members[0].Id.ValueString())
+	suite.True(members[0].UserEmail.IsNull())
+	suite.Equal("ci", members[0].ServiceAccountName.ValueString())
+	suite.Equal("test@syseleven.net", members[1].UserEmail.ValueString())
+	suite.Equal("owner", members[1].Affiliation.ValueString())
+	suite.True(members[1].ServiceAccountId.IsNull())
+	var nonEditablePermissions []string
+	members[1].NonEditablePermissions.ElementsAs(context.Background(), &nonEditablePermissions, false)
+	suite.Equal([]string{"can_do", "will_do"}, nonEditablePermissions)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationMembersReadByMembershipType() {
+	memberships := []iam.IAMOrganizationMembership{
+		{ID: "3", Organisation: exampleOrganization, MembershipType: "service_account", ServiceAccount: iam.IAMOrganisationServiceAccount{ID: "4", Name: "ci"}},
+		{ID: "2", Organisation: exampleOrganization, MembershipType: "user", User: exampleUser},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(memberships)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationMembersDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "membership_type": "user"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var members []datasource_organization_members.OrganizationMemberModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("members"), &members)
+	suite.Len(members, 1)
+	suite.Equal("2", members[0].Id.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestProjectMembersRead() {
+	memberships := []iam.IAMProjectMembership{
+		{MembershipType: "user", User: iam.IAMOrganisationUser{ID: "5", Email: "other@syseleven.net"}, Project: exampleProject, Permissions: []string{"can_view"}},
+		{MembershipType: "service_account", ServiceAccount: iam.IAMOrganisationServiceAccount{ID: "4", Name: "ci"}, Project: exampleProject, Permissions: []string{"can_admin"}},
+		{MembershipType: "user", User: exampleUser, Project: exampleProject, Permissions: []string{"can_view", "can_admin"}},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(memberships)),
+	)
+	defer mockServer.Close()
+
+	d := NewProjectMembersDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "project_id": "2", "permission": "can_admin"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var members []datasource_project_members.ProjectMemberModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("members"), &members)
+	suite.Len(members, 2)
+	suite.Equal("4", members[0].ServiceAccountId.ValueString())
+	suite.True(members[0].UserId.IsNull())
+	suite.Equal("1", members[1].UserId.ValueString())
+	var permissions []string
+	members[1].Permissions.ElementsAs(context.Background(), &permissions, false)
+	suite.Equal([]string{"can_admin", "can_view"}, permissions)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationTeamsRead() {
+	teams := []iam.IAMOrganizationTeam{
+		{ID: "3", Name: "other-team", Tags: []string{"other-tag"}},
+		{ID: "2", Name: "sample-team", Description: "sample-team", Tags: []string{"sample-tag"}},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/teams").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(teams)),
+		responses.Expect("GET", "/v2/orgs/1/teams/2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`["will_do", "can_do"]`)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationTeamsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "tag": "sample-tag"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var result []datasource_organization_teams.OrganizationTeamModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("teams"), &result)
+	suite.Len(result, 1)
+	suite.Equal("sample-team", result[0].Name.ValueString())
+	var permissions []string
+	result[0].Permissions.ElementsAs(context.Background(), &permissions, false)
+	suite.Equal([]string{"can_do", "will_do"}, permissions)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationTeamMembersRead() {
+	team := iam.IAMOrganizationTeam{ID: "2", Name: "sample-team"}
+	memberships := []iam.IAMOrganizationTeamMembership{
+		{MembershipType: "user", User: exampleUser, Organisation: exampleOrganization, Team: team, TeamPermissions: []string{"can_do"}, OrganizationPermissions: []string{"can_view"}},
+		{MembershipType: "service_account", ServiceAccount: iam.IAMOrganisationServiceAccount{ID: "4", Name: "ci"}, Organisation: exampleOrganization, Team: team, TeamPermissions: []string{"can_do"}},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/teams/2/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(memberships)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationTeamMembersDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "team_id": "2"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var members []datasource_organization_team_members.OrganizationTeamMemberModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("members"), &members)
+	suite.Len(members, 2)
+	suite.Equal("ci", members[0].ServiceAccountName.ValueString())
+	suite.Equal("test@syseleven.net", members[1].UserEmail.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestPermissionsRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(examplePermissions),
+	)
+	defer mockServer.Close()
+
+	d := NewPermissionsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.assertDataSourceAttribute(readResponse, "organization_permissions", []string{"can_manage_teams"})
+	suite.assertDataSourceAttribute(readResponse, "project_permissions", []string{"can_become_administrator_in_project"})
+
+	var permissions []datasource_permissions.PermissionModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("permissions"), &permissions)
+	suite.Len(permissions, 2)
+	suite.Equal("organization", permissions[0].Scope.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestCurrentIdentityReadUser() {
+	identity := iam.IAMCurrentIdentity{
+		Type:  "user",
+		ID:    "1",
+		Name:  "Test User",
+		Email: "test@syseleven.net",
+		Organizations: []iam.IAMCurrentIdentityOrganization{
+			{ID: "2", Name: "other-org", Permissions: []string{"can_read_members_in_org", "can_create_projects_in_org"}},
+			{ID: "1", Name: "sample-org", Permissions: []string{"can_create_projects_in_org"}},
+		},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/me").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(identity)),
+	)
+	defer mockServer.Close()
+
+	d := NewCurrentIdentityDataSource()
+	config := suite.newConfig(d, map[string]interface{}{})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.assertDataSourceAttribute(readResponse, "email", "test@syseleven.net")
+	suite.assertDataSourceAttribute(readResponse, "permissions", []string{"can_create_projects_in_org", "can_read_members_in_org"})
+
+	var organizations []datasource_current_identity.OrganizationModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("organizations"), &organizations)
+	suite.Len(organizations, 2)
+	suite.Equal("other-org", organizations[0].Name.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestCurrentIdentityReadServiceAccount() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/me").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`{"type": "service_account", "id": "4", "name": "ci", "email": "", "organizations": []}`)),
+	)
+	defer mockServer.Close()
+
+	d := NewCurrentIdentityDataSource()
+	config := suite.newConfig(d, map[string]interface{}{})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.assertDataSourceAttribute(readResponse, "type", "service_account")
+	suite.assertDataSourceAttribute(readResponse, "email", types.StringNull())
+	mockServer.HasExpectedRequests()
+}
+
+var exampleInvitations = []iam.IAMOrganizationInvitation{
+	{ID: "1", Email: "expired@syseleven.net", ExpirationDate: "2001-01-01T00:00:00Z", Permissions: []string{"can_do"}, CreatedAt: "date"},
+	{ID: "2", Email: "pending@syseleven.net", ExpirationDate: "2999-01-01T00:00:00.000000", Permissions: []string{"can_do"}, CreatedAt: "date"},
+	{ID: "3", Email: "unknown@syseleven.net", ExpirationDate: "soon", CreatedAt: "date"},
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationInvitationsRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleInvitations)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationInvitationsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var invitations []datasource_organization_invitations.OrganizationInvitationModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("invitations"), &invitations)
+	suite.Len(invitations, 3)
+	suite.True(invitations[0].Expired.ValueBool())
+	suite.False(invitations[1].Expired.ValueBool())
+	suite.True(invitations[2].Expired.IsNull())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationInvitationsReadExpired() {
+	for expired, email := range map[bool]string{true: "expired@syseleven.net", false: "pending@syseleven.net"} {
+		mockServer := responses.NewMockServer(
+			&suite.Suite,
+			responses.Expect("GET", "/v1/orgs/1/invitations").
+				ReturnWithCode(http.StatusOK).
+				ReturnWithBody(suite.marshal(exampleInvitations)),
+		)
+
+		d := NewOrganizationInvitationsDataSource()
+		config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "expired": expired})
+		readResponse := suite.readDataSource(mockServer, d, config)
+		suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+		var invitations []datasource_organization_invitations.OrganizationInvitationModel
+		readResponse.State.GetAttribute(context.Background(), path.Root("invitations"), &invitations)
+		suite.Len(invitations, 1)
+		suite.Equal(email, invitations[0].Email.ValueString())
+		mockServer.HasExpectedRequests()
+		mockServer.Close()
+	}
+}
+
+func (suite *DataSourceTestSuite) TestProjectS3usersRead() {
+	s3users := []iam.IAMProjectS3User{
+		{ID: "4", Name: "backup", Keys: []iam.IAMProjectS3UserKey{
+			{AccessKey: "new-access-key", SecretKey: "new-secret-key", CreatedAt: "2024-05-01T00:00:00Z"},
+			{AccessKey: "old-access-key", SecretKey: "old-secret-key", CreatedAt: "2021-05-01T00:00:00Z"},
+		}},
+		{ID: "3", Name: "assets", Keys: []iam.IAMProjectS3UserKey{}},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/s3-users").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(s3users)),
+	)
+	defer mockServer.Close()
+
+	d := NewProjectS3usersDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "project_id": "2"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+	suite.NotContains(readResponse.State.Raw.String(), "secret-key")
+
+	var result []datasource_project_s3users.ProjectS3userModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("s3users"), &result)
+	suite.Len(result, 2)
+	suite.Equal("assets", result[0].Name.ValueString())
+	var keys []datasource_project_s3users.ProjectS3userKeyModel
+	result[1].Keys.ElementsAs(context.Background(), &keys, false)
+	suite.Len(keys, 2)
+	suite.Equal("old-access-key", keys[0].AccessKey.ValueString())
+	suite.Equal("2021-05-01T00:00:00Z", keys[0].CreatedAt.ValueString())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *DataSourceTestSuite) TestOrganizationContactsReadByRole() {
+	contacts := []iam.IAMOrganizationContact{
+		{ID: "3", FirstName: "Erika", LastName: "Musterfrau", Email: "billing@syseleven.net", Roles: []string{"Billing"}},
+		{ID: "2", FirstName: "Max", LastName: "Mustermann", Email: "ops@syseleven.net", Phone: "+49", Roles: []string{"Technical", "Billing"}},
+		{ID: "1", FirstName: "Anna", LastName: "Mustermann", Email: "dev@syseleven.net", Roles: []string{"technical"}},
+	}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/contacts").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(contacts)),
+	)
+	defer mockServer.Close()
+
+	d := NewOrganizationContactsDataSource()
+	config := suite.newConfig(d, map[string]interface{}{"organization_id": "1", "role": "Technical"})
+	readResponse := suite.readDataSource(mockServer, d, config)
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+
+	var result []datasource_organization_contacts.OrganizationContactModel
+	readResponse.State.GetAttribute(context.Background(), path.Root("contacts"), &result)
+	suite.Len(result, 2)
+	suite.Equal("Anna", result[0].FirstName.ValueString())
+	suite.Equal("ops@syseleven.net", result[1].Email.ValueString())
+	var roles []string
+	result[1].Roles.ElementsAs(context.Background(), &roles, false)
+	suite.Equal([]string{"Billing", "Technical"}, roles)
+	mockServer.HasExpectedRequests()
+}
+
+func TestDataSourceTestSuite(t *testing.T) {
+	suite.Run(t, new(DataSourceTestSuite))
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+)
+
+// clientErrorSummary is the summary of diagnostics for errors of the iam client
+const clientErrorSummary = "IAM API error"
+
+// addClientError adds err to the diagnostics. Field errors reported by the iam
+// service are added with the field name, as there is no schema to resolve the
+// attribute they refer to.
+func addClientError(diagnostics *diag.Diagnostics, err error) {
+	addFieldErrors(diagnostics, err, nil)
+}
+
+// addPlanClientError adds err to the diagnostics like addClientError, but
+// attaches field errors to the attribute of the plan they refer to, so
+// terraform can point at the offending line of the configuration.
+func addPlanClientError(ctx context.Context, diagnostics *diag.Diagnostics, plan tfsdk.Plan, err error) {
+	addFieldErrors(diagnostics, err, func(p path.Path) bool {
+		if plan.Schema == nil {
+			return false
+		}
+		_, diags := plan.Schema.TypeAtPath(ctx, p)
+		return !diags.HasError()
+	})
+}
+
+// addFieldErrors adds a diagnostic for every field error of err. Field errors
+// are only attached to an attribute if exists reports that its path is part of
+// the schema, otherwise terraform would fail on the unknown path.
+func addFieldErrors(diagnostics *diag.Diagnostics, err error, exists func(path.Path) bool) {
+	var apiError *iam.APIError
+	if !errors.As(err, &apiError) {
+		diagnostics.AddError(clientErrorSummary, err.Error())
+		return
+	}
+	summary := fmt.Sprintf("%s: %d %s", clientErrorSummary, apiError.StatusCode, http.StatusText(apiError.StatusCode))
+	if len(apiError.FieldErrors) == 0 {
+		diagnostics.AddError(summary, err.Error())
+		return
+	}
+fieldErrors:
+	for _, fieldError := range apiError.FieldErrors {
+		// the field message alone lacks the request id needed for support
+		detail := fmt.Sprintf("%s\n\n%s", fieldError.Message, apiError.Error())
+		if fieldError.Field == "" {
+			diagnostics.AddError(summary, detail)
+			continue
+		}
+		if exists != nil {
+			for _, p := range fieldPaths(fieldError.Field) {
+				if exists(p) {
+					diagnostics.AddAttributeError(p, summary, detail)
+					continue fieldErrors
+				}
+			}
+		}
+		diagnostics.AddError(summary, fmt.Sprintf("%s: %s", fieldError.Field, detail))
+	}
+}
+
+// fieldPaths returns the attribute paths a field reported by the iam service,
+// e.g. "company_info.vat_id" or "body.tags.0", may refer to. Nested fields of
+// the API are either nested attributes or flattened into one attribute, e.g.
+// company_info_vat_id.
+func fieldPaths(field string) []path.Path {
+	segments := strings.Split(field, ".")
+	if len(segments) > 1 && segments[0] == "body" {
+		segments = segments[1:]
+	}
+
+	nested := path.Root(segments[0])
+	for _, segment := range segments[1:] {
+		if index, err := strconv.Atoi(segment); err == nil {
+			nested = nested.AtListIndex(index)
+		} else {
+			nested = nested.AtName(segment)
+		}
+	}
+
+	names := []string{}
+	var indices []int
+	for _, segment := range segments {
+		if index, err := strconv.Atoi(segment); err == nil {
+			indices = append(indices, index)
+		} else if len(indices) == 0 {
+			names = append(names, segment)
+		} else {
+			// names after an index can not be flattened
+			return []path.Path{nested}
+		}
+	}
+	flattened := path.Root(strings.Join(names, "_"))
+	for _, index := range indices {
+		flattened = flattened.AtListIndex(index)
+	}
+	if flattened.Equal(nested) {
+		return []path.Path{nested}
+	}
+	return []path.Path{nested, flattened}
+}
@@ -67,9 +67,9 @@ func (r *OrganizationContactResource) Create(ctx context.Context, req resource.C
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -86,9 +86,9 @@ func (r *OrganizationContactResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	response, err := r.client.CreateOrganizationContact(data.OrganizationId.ValueString(), data.FirstName.ValueString(), data.LastName.ValueString(), data.Notes.ValueString(), data.Email.ValueString(), data.Phone.ValueString(), elements)
+	response, err := r.client.CreateOrganizationContact(ctx, data.OrganizationId.ValueString(), data.FirstName.ValueString(), data.LastName.ValueString(), data.Notes.ValueString(), data.Email.ValueString(), data.Phone.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -112,9 +112,14 @@ func (r *OrganizationContactResource) Read(ctx context.Context, req resource.Rea
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationContact resource.")
-	response, err := r.client.GetOrganizationContact(data.OrganizationId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetOrganizationContact(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "OrganizationContact not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -147,9 +152,9 @@ func (r *OrganizationContactResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	response, err := r.client.UpdateOrganizationContact(data.OrganizationId.ValueString(), data.Id.ValueString(), data.FirstName.ValueString(), data.LastName.ValueString(), data.Notes.ValueString(), data.Email.ValueString(), data.Phone.ValueString(), elements)
+	response, err := r.client.UpdateOrganizationContact(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.FirstName.ValueString(), data.LastName.ValueString(), data.Notes.ValueString(), data.Email.ValueString(), data.Phone.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -178,9 +183,9 @@ func (r *OrganizationContactResource) Delete(ctx context.Context, req resource.D
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting OrganizationContact resource.")
-	err := r.client.DeleteOrganizationContact(data.OrganizationId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteOrganizationContact(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -202,9 +207,9 @@ func (r *OrganizationContactResource) ImportState(ctx context.Context, req resou
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationContact resource.")
-	response, err := r.client.GetOrganizationContact(idParts[0], idParts[1])
+	response, err := r.client.GetOrganizationContact(ctx, idParts[0], idParts[1])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_contacts"
+)
+
+var (
+	_ datasource.DataSource              = &organizationContactsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationContactsDataSource{}
+)
+
+func NewOrganizationContactsDataSource() datasource.DataSource {
+	return &organizationContactsDataSource{}
+}
+
+type organizationContactsDataSource struct {
+	client *iam.Client
+}
+
+func (r *organizationContactsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_contacts"
+}
+
+func (r *organizationContactsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_contacts.OrganizationContactsDataSourceSchema(ctx)
+}
+
+func (r *organizationContactsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationContactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_organization_contacts.OrganizationContactsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading organization contacts datasource.")
+	response, err := r.client.ListOrganizationContacts(ctx, data.OrganizationId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	contacts := []datasource_organization_contacts.OrganizationContactModel{}
+	for _, contact := range response {
+		if !data.Role.IsNull() && !slices.ContainsFunc(contact.Roles, func(role string) bool {
+			return strings.EqualFold(role, data.Role.ValueString())
+		}) {
+			continue
+		}
+
+		sort.Sort(sort.StringSlice(contact.Roles))
+		roles, diags := types.ListValueFrom(ctx, types.StringType, contact.Roles)
+		resp.Diagnostics.Append(diags...)
+		contacts = append(contacts, datasource_organization_contacts.OrganizationContactModel{
+			Id:        types.StringValue(contact.ID),
+			FirstName: types.StringValue(contact.FirstName),
+			LastName:  types.StringValue(contact.LastName),
+			Email:     types.StringValue(contact.Email),
+			Phone:     types.StringValue(contact.Phone),
+			Notes:     types.StringValue(contact.Notes),
+			Roles:     roles,
+		})
+	}
+	sort.Slice(contacts, func(i, j int) bool {
+		if contacts[i].LastName.ValueString() != contacts[j].LastName.ValueString() {
+			return contacts[i].LastName.ValueString() < contacts[j].LastName.ValueString()
+		}
+		return contacts[i].FirstName.ValueString() < contacts[j].FirstName.ValueString()
+	})
+
+	contactList, diags := types.ListValueFrom(ctx, datasource_organization_contacts.OrganizationContactType, contacts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Contacts = contactList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
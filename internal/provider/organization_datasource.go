@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
@@ -12,7 +14,8 @@ import (
 )
 
 var (
-	_ datasource.DataSource = &organizationDataSource{}
+	_ datasource.DataSource                     = &organizationDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &organizationDataSource{}
 )
 
 func NewOrganizationDataSource() datasource.DataSource {
@@ -31,6 +34,15 @@ func (r *organizationDataSource) Schema(ctx context.Context, req datasource.Sche
 	resp.Schema = datasource_organization.OrganizationDataSourceSchema(ctx)
 }
 
+func (r *organizationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (r *organizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -62,26 +74,41 @@ func (r *organizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading organization resource.")
-	response, err := r.client.GetOrganizationByName(data.Name.ValueString())
+	var response iam.IAMOrganization
+	var err error
+	if !data.Id.IsNull() {
+		response, err = r.client.GetOrganization(ctx, data.Id.ValueString())
+	} else {
+		response, err = r.client.GetOrganizationByName(ctx, data.Name.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
-	if response.Name != data.Name.ValueString() {
-		response, err = r.client.GetOrganization(data.Id.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("", err.Error())
-			return
-		}
+	if !data.Name.IsNull() && data.Name.ValueString() != response.Name {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "OrganizationNameMismatchError",
+			fmt.Sprintf("Organization with id %s is named %q, not %q.", response.ID, response.Name, data.Name.ValueString()))
+		return
 	}
 
 	// Data value setting
+	data.Id = types.StringValue(response.ID)
 	data.Name = types.StringValue(response.Name)
 	data.Description = types.StringValue(response.Description)
 	data.CreatedAt = types.StringValue(response.CreatedAt)
 	data.UpdatedAt = types.StringValue(response.UpdatedAt)
 	data.IsActive = types.BoolValue(response.IsActive)
 	data.Tags, _ = types.ListValueFrom(ctx, types.StringType, response.Tags)
+	data.CompanyInfoStreet = types.StringValue(response.CompanyInfo.Street)
+	data.CompanyInfoStreetNumber = types.StringValue(response.CompanyInfo.StreetNumber)
+	data.CompanyInfoZipCode = types.StringValue(response.CompanyInfo.ZipCode)
+	data.CompanyInfoCity = types.StringValue(response.CompanyInfo.City)
+	data.CompanyInfoCountry = types.StringValue(response.CompanyInfo.Country)
+	data.CompanyInfoVatID = types.StringValue(response.CompanyInfo.VatID)
+	data.CompanyInfoPreferredBillingMethod = types.StringValue(response.CompanyInfo.PreferredBillingMethod)
+	data.CompanyInfoPhone = types.StringValue(response.CompanyInfo.Phone)
+	data.CompanyInfoAcceptedTos = types.BoolValue(response.CompanyInfo.AcceptedTos)
+	data.CompanyInfoCompanyName = types.StringValue(response.CompanyInfo.CompanyName)
 
 	// Emit manual steps as warnings
 	if !data.IsActive.ValueBool() {
@@ -93,48 +120,3 @@ func (r *organizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-/**
-func (r *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state datasource_organization.OrganizationModel
-
-	// Read Terraform prior state data into the model
-	//resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	// Read API call logic
-	tflog.Info(ctx, "Reading organization datasource.")
-	response, err := r.client.GetOrganizations()
-	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
-		return
-	}
-
-	// Map response body to model
-	for _, org := range response {
-		orgState := datasource_organization.OrganizationModel{
-			Id:          types.StringValue(org.ID),
-			Name:        types.StringValue(org.Name),
-			Description: types.StringValue(org.Description),
-			CreatedAt:   types.StringValue(org.CreatedAt),
-			UpdatedAt:   types.StringValue(org.UpdatedAt),
-			IsActive:    types.BoolValue(org.IsActive),
-			Tags:        types.ListValueFrom(ctx, types.StringType, org.Tags),
-		}
-		state = append(state, orgState)
-
-		// Emit manual steps as warnings
-		if !org.IsActive {
-			resp.Diagnostics.AddWarning("OrganizationNotActiveWarning",
-				fmt.Sprintf("Organization with id %s is not active. Organization activation is a manual step, please contact an IAM administrator.",
-					org.ID))
-		}
-	}
-
-	// Set state
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
-**/
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/resource_organization_invitation"
+)
+
+// statuses of an organization invitation
+const (
+	invitationPending  = "pending"
+	invitationAccepted = "accepted"
+	invitationExpired  = "expired"
+)
+
+var _ resource.Resource = (*OrganizationInvitationResource)(nil)
+var _ resource.ResourceWithConfigure = (*OrganizationInvitationResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*OrganizationInvitationResource)(nil)
+
+func NewOrganizationInvitationResource() resource.Resource {
+	return &OrganizationInvitationResource{}
+}
+
+type OrganizationInvitationResource struct {
+	client *iam.Client
+}
+
+func (r *OrganizationInvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitation"
+}
+
+func (r *OrganizationInvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_organization_invitation.OrganizationInvitationResourceSchema(ctx)
+}
+
+func (r *OrganizationInvitationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInvitationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("permissions"), iam.OrganizationPermissionScope, &resp.Diagnostics)
+
+	// nothing to re-send when the invitation is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan resource_organization_invitation.OrganizationInvitationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() != invitationExpired || !plan.ResendOnExpiry.ValueBool() {
+		return
+	}
+
+	// An expired invitation is sent again in Update
+	plan.Status = types.StringValue(invitationPending)
+	plan.Id = types.StringUnknown()
+	plan.ExpirationDate = types.StringUnknown()
+	plan.CreatedAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_organization_invitation.OrganizationInvitationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	tflog.Info(ctx, "Creating OrganizationInvitation resource.")
+	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
+	// Is the organization active?
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
+	if err != nil {
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+		return
+	}
+	if !org_response.IsActive {
+		resp.Diagnostics.AddError("OrganizationNotActiveError",
+			fmt.Sprintf("Can not create OrganizationInvitation in organization with id %s as it is not active. Organization activation is a manual step, please contact the SysEleven GmbH Sales Team <sales@syseleven.de>.\n This can also be done via https://dashboard.syseleven.de/dashboard",
+				data.OrganizationId.ValueString()))
+		return
+	}
+
+	r.invite(ctx, req.Plan, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_organization_invitation.OrganizationInvitationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading OrganizationInvitation resource.")
+	response, err := r.client.GetOrganizationInvitationByEmail(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+	if err != nil && !iam.IsNoMatch(err) {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+	if err != nil {
+		// Invitations are removed once they are accepted
+		_, err := r.client.GetOrganizationMembershipByEmail(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+		if err != nil {
+			if iam.IsNoMatch(err) {
+				tflog.Warn(ctx, "OrganizationInvitation not found, removing it from state.")
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			addClientError(&resp.Diagnostics, err)
+			return
+		}
+		data.Status = types.StringValue(invitationAccepted)
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Data value setting
+	r.setInvitation(ctx, &data, response, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_organization_invitation.OrganizationInvitationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	var status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if status.ValueString() != invitationExpired || data.Status.ValueString() != invitationPending {
+		// Update API call logic
+		tflog.Info(ctx, "OrganizationInvitation can't be updated. Passing in unchanged state.")
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Update API call logic
+	tflog.Info(ctx, "Re-sending expired OrganizationInvitation.")
+	err := r.client.DeleteOrganizationInvitation(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+	if err != nil {
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+		return
+	}
+
+	r.invite(ctx, req.Plan, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_organization_invitation.OrganizationInvitationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	if data.Status.ValueString() == invitationAccepted {
+		tflog.Info(ctx, "OrganizationInvitation has been accepted, nothing to revoke. The membership is managed by the sys11iam_organization_membership resource.")
+		return
+	}
+	tflog.Info(ctx, "Deleting OrganizationInvitation resource.")
+	err := r.client.DeleteOrganizationInvitation(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+}
+
+func (r *OrganizationInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: org_id,email. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading OrganizationInvitation resource.")
+	response, err := r.client.GetOrganizationInvitationByEmail(ctx, idParts[0], idParts[1])
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	var data resource_organization_invitation.OrganizationInvitationModel
+
+	// Data value setting
+	data.OrganizationId = types.StringValue(idParts[0])
+	data.Email = types.StringValue(idParts[1])
+	data.Permissions = types.ListNull(types.StringType)
+	data.ResendOnExpiry = types.BoolValue(true)
+	r.setInvitation(ctx, &data, response, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// invite sends the invitation described by data and sets the computed
+// attributes from the response
+func (r *OrganizationInvitationResource) invite(ctx context.Context, plan tfsdk.Plan, data *resource_organization_invitation.OrganizationInvitationModel, diagnostics *diag.Diagnostics) {
+	permissions := make([]string, 0, len(data.Permissions.Elements()))
+	diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.CreateOrganizationInvitation(ctx, data.OrganizationId.ValueString(), data.Email.ValueString(), permissions)
+	if err != nil {
+		addPlanClientError(ctx, diagnostics, plan, err)
+		return
+	}
+	r.setInvitation(ctx, data, response, diagnostics)
+}
+
+// setInvitation sets the attributes of data from the invitation returned by
+// the API. The status is derived from the expiration date.
+func (r *OrganizationInvitationResource) setInvitation(ctx context.Context, data *resource_organization_invitation.OrganizationInvitationModel, invitation iam.IAMOrganizationInvitation, diagnostics *diag.Diagnostics) {
+	data.Id = types.StringValue(invitation.ID)
+	data.ExpirationDate = types.StringValue(invitation.ExpirationDate)
+	data.CreatedAt = types.StringValue(invitation.CreatedAt)
+	permissions, diags := permissionsValue(ctx, data.Permissions, invitation.Permissions)
+	diagnostics.Append(diags...)
+	data.Permissions = permissions
+
+	data.Status = types.StringValue(invitationPending)
+	expiresAt, err := invitation.ExpiresAt()
+	if err != nil {
+		tflog.Warn(ctx, "Could not determine whether the invitation has expired.", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if !expiresAt.After(time.Now()) {
+		data.Status = types.StringValue(invitationExpired)
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_invitations"
+)
+
+var (
+	_ datasource.DataSource              = &organizationInvitationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationInvitationsDataSource{}
+)
+
+func NewOrganizationInvitationsDataSource() datasource.DataSource {
+	return &organizationInvitationsDataSource{}
+}
+
+type organizationInvitationsDataSource struct {
+	client *iam.Client
+}
+
+func (r *organizationInvitationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitations"
+}
+
+func (r *organizationInvitationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_invitations.OrganizationInvitationsDataSourceSchema(ctx)
+}
+
+func (r *organizationInvitationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationInvitationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_organization_invitations.OrganizationInvitationsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading organization invitations datasource.")
+	response, err := r.client.ListOrganizationInvitations(ctx, data.OrganizationId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	now := time.Now()
+	invitations := []datasource_organization_invitations.OrganizationInvitationModel{}
+	for _, invitation := range response {
+		expired := types.BoolNull()
+		expiresAt, err := invitation.ExpiresAt()
+		if err != nil {
+			tflog.Warn(ctx, "Could not determine whether the invitation has expired.", map[string]interface{}{"error": err.Error()})
+		} else {
+			expired = types.BoolValue(!expiresAt.After(now))
+		}
+		// invitations with an unknown expiration date match neither filter value
+		if !data.Expired.IsNull() && !data.Expired.Equal(expired) {
+			continue
+		}
+
+		sort.Sort(sort.StringSlice(invitation.Permissions))
+		permissions, diags := types.ListValueFrom(ctx, types.StringType, invitation.Permissions)
+		resp.Diagnostics.Append(diags...)
+		invitations = append(invitations, datasource_organization_invitations.OrganizationInvitationModel{
+			Id:             types.StringValue(invitation.ID),
+			Email:          types.StringValue(invitation.Email),
+			Permissions:    permissions,
+			ExpirationDate: types.StringValue(invitation.ExpirationDate),
+			Expired:        expired,
+			CreatedAt:      types.StringValue(invitation.CreatedAt),
+		})
+	}
+	sort.Slice(invitations, func(i, j int) bool {
+		return invitations[i].Email.ValueString() < invitations[j].Email.ValueString()
+	})
+
+	invitationList, diags := types.ListValueFrom(ctx, datasource_organization_invitations.OrganizationInvitationType, invitations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Invitations = invitationList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_members"
+)
+
+var (
+	_ datasource.DataSource              = &organizationMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationMembersDataSource{}
+)
+
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &organizationMembersDataSource{}
+}
+
+type organizationMembersDataSource struct {
+	client *iam.Client
+}
+
+func (r *organizationMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (r *organizationMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_members.OrganizationMembersDataSourceSchema(ctx)
+}
+
+func (r *organizationMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_organization_members.OrganizationMembersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading organization members datasource.")
+	response, err := r.client.ListOrganizationMemberships(ctx, data.OrganizationId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	members := []datasource_organization_members.OrganizationMemberModel{}
+	for _, membership := range response {
+		if !data.MembershipType.IsNull() && data.MembershipType.ValueString() != membership.MembershipType {
+			continue
+		}
+		if !data.Permission.IsNull() &&
+			!slices.Contains(membership.Permissions, data.Permission.ValueString()) &&
+			!slices.Contains(membership.ImmutablePermissions, data.Permission.ValueString()) {
+			continue
+		}
+
+		sort.Sort(sort.StringSlice(membership.Permissions))
+		sort.Sort(sort.StringSlice(membership.ImmutablePermissions))
+		permissions, diags := types.ListValueFrom(ctx, types.StringType, membership.Permissions)
+		resp.Diagnostics.Append(diags...)
+		nonEditablePermissions, diags := types.ListValueFrom(ctx, types.StringType, membership.ImmutablePermissions)
+		resp.Diagnostics.Append(diags...)
+		members = append(members, datasource_organization_members.OrganizationMemberModel{
+			Id:                     types.StringValue(membership.ID),
+			Affiliation:            types.StringValue(membership.Affiliation),
+			MembershipType:         types.StringValue(membership.MembershipType),
+			UserId:                 stringOrNull(membership.User.ID),
+			UserEmail:              stringOrNull(membership.User.Email),
+			UserName:               stringOrNull(membership.User.Name),
+			ServiceAccountId:       stringOrNull(membership.ServiceAccount.ID),
+			ServiceAccountName:     stringOrNull(membership.ServiceAccount.Name),
+			Permissions:            permissions,
+			NonEditablePermissions: nonEditablePermissions,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].UserEmail.ValueString() != members[j].UserEmail.ValueString() {
+			return members[i].UserEmail.ValueString() < members[j].UserEmail.ValueString()
+		}
+		return members[i].ServiceAccountName.ValueString() < members[j].ServiceAccountName.ValueString()
+	})
+
+	memberList, diags := types.ListValueFrom(ctx, datasource_organization_members.OrganizationMemberType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = memberList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// stringOrNull maps the empty strings of fields the API leaves unset, e.g.
+// the user of a service account membership, to null
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +19,15 @@ import (
 
 var _ resource.Resource = (*OrganizationMembershipResource)(nil)
 var _ resource.ResourceWithConfigure = (*OrganizationMembershipResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*OrganizationMembershipResource)(nil)
+
+// defaultAcceptanceTimeout is the time to wait for the invited user to accept
+// the invitation if the create timeout is not configured
+const defaultAcceptanceTimeout = 30 * time.Minute
+
+// pendingInvitationId is the id earlier versions of the provider stored for
+// memberships of users that had not accepted their invitation yet
+const pendingInvitationId = "0"
 
 func NewOrganizationMembershipResource() resource.Resource {
 	return &OrganizationMembershipResource{}
@@ -52,6 +64,10 @@ func (r *OrganizationMembershipResource) Configure(_ context.Context, req resour
 	r.client = client
 }
 
+func (r *OrganizationMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("editable_permissions"), iam.OrganizationPermissionScope, &resp.Diagnostics)
+}
+
 func (r *OrganizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_organization_membership.OrganizationMembershipModel
 
@@ -65,11 +81,10 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 	// Create API call logic
 	tflog.Info(ctx, "Creating OrganizationMembership resource.")
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
-	data.IsActive = types.BoolValue(false)
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -88,26 +103,21 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 
 	// Is the e-mail already a member?
 	if data.Email.ValueString() != "" {
-		org_membership_response, err := r.client.GetOrganizationMembershipByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
+		org_membership_response, err := r.client.GetOrganizationMembershipByEmail(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+		if err != nil && !iam.IsNoMatch(err) {
+			addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+			return
+		}
 		if data.Id.ValueString() == "" && err != nil {
-			// Is the e-mail at least invited?
-			_, err := r.client.GetOrganizationInvitationByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
+			// The user has to accept an invitation first
+			org_membership_response, err = r.waitForAcceptance(ctx, &data, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
 			if err != nil {
-				// Invite the e-mail
-				_, err := r.client.CreateOrganizationInvitation(data.OrganizationId.ValueString(), data.Email.ValueString(), elements)
-				if err != nil {
-					resp.Diagnostics.AddError("", err.Error())
-					return
-				}
+				addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+				return
 			}
-			// The email is invited, but has to be activated manually
-			resp.Diagnostics.AddWarning("InvitationNotAcceptedWarning",
-				fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the user with the e-mail %s has not yet accepted the invitation. Invitation accepting is a manual step, please contact the invited user.",
-					data.OrganizationId.ValueString(), data.Email.ValueString()))
-			// Save data into Terraform state
-			data.Id = types.StringValue("0")
-			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			return
 		}
 
 		if org_membership_response.ServiceAccount.ID != "" {
@@ -119,9 +129,9 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 		}
 	}
 
-	response, err := r.client.CreateOrganizationMembership(data.OrganizationId.ValueString(), data.Id.ValueString(), data.Affiliation.ValueString(), elements)
+	response, err := r.client.CreateOrganizationMembership(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.Affiliation.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -137,12 +147,81 @@ func (r *OrganizationMembershipResource) Create(ctx context.Context, req resourc
 	data.OrganizationId = types.StringValue(response.Organisation.ID)
 	sort.Sort(sort.StringSlice(response.Permissions))
 	data.EditablePermissions, _ = types.ListValueFrom(ctx, types.StringType, response.Permissions)
-	//data.IsActive = types.BoolValue(true)
+	data.IsActive = types.BoolValue(true)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// waitForAcceptance returns the membership of the invited user. Unless
+// wait_for_acceptance is set, an error is added right away if the user has not
+// accepted the invitation yet.
+func (r *OrganizationMembershipResource) waitForAcceptance(ctx context.Context, data *resource_organization_membership.OrganizationMembershipModel, diagnostics *diag.Diagnostics) (iam.IAMOrganizationMembership, error) {
+	var membership iam.IAMOrganizationMembership
+	organizationId := data.OrganizationId.ValueString()
+	email := data.Email.ValueString()
+
+	// checkAcceptance reports whether the user is a member and fails if the
+	// user is not invited (anymore)
+	checkAcceptance := func(ctx context.Context) (bool, error) {
+		response, err := r.client.GetOrganizationMembershipByEmail(ctx, organizationId, email)
+		if err == nil {
+			membership = response
+			return true, nil
+		}
+		if !iam.IsNoMatch(err) {
+			return false, err
+		}
+		invitation, err := r.client.GetOrganizationInvitationByEmail(ctx, organizationId, email)
+		if iam.IsNoMatch(err) {
+			diagnostics.AddError("MemberNotFoundError",
+				fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the user with the e-mail %s is not a member. Invite the user with the sys11iam_organization_invitation resource first.",
+					organizationId, email))
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if expiresAt, err := invitation.ExpiresAt(); err == nil && !expiresAt.After(time.Now()) {
+			diagnostics.AddError("InvitationExpiredError",
+				fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the invitation of the user with the e-mail %s expired on %s.",
+					organizationId, email, invitation.ExpirationDate))
+			return false, nil
+		}
+		return false, nil
+	}
+
+	if !data.WaitForAcceptance.ValueBool() {
+		accepted, err := checkAcceptance(ctx)
+		if err == nil && !accepted && !diagnostics.HasError() {
+			diagnostics.AddError("InvitationNotAcceptedError",
+				fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the user with the e-mail %s has not yet accepted the invitation. Invitation accepting is a manual step, please contact the invited user or set wait_for_acceptance.",
+					organizationId, email))
+		}
+		return membership, err
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultAcceptanceTimeout)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return membership, nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for the user with the e-mail %s to accept the invitation.", timeout, email))
+	err := waitFor(ctx, timeout, func(ctx context.Context) (bool, error) {
+		accepted, err := checkAcceptance(ctx)
+		// stop waiting when the user can not accept the invitation anymore
+		return accepted || diagnostics.HasError(), err
+	})
+	if errors.Is(err, errWaitTimeout) {
+		diagnostics.AddError("InvitationNotAcceptedError",
+			fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the user with the e-mail %s has not accepted the invitation within %s.",
+				organizationId, email, timeout))
+		return membership, nil
+	}
+	return membership, err
+}
+
 func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data resource_organization_membership.OrganizationMembershipModel
 
@@ -155,33 +234,29 @@ func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationMembership resource.")
-	if data.Email.ValueString() != "" && !data.IsActive.ValueBool() {
-		_, err := r.client.GetOrganizationInvitationByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
-		if err == nil {
-			// The email is invited, but has to be activated manually
-			resp.Diagnostics.AddWarning("InvitationNotAcceptedWarning",
-				fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the user with the e-mail %s has not yet accepted the invitation. Invitation accepting is a manual step, please contact the invited user.",
-					data.OrganizationId.ValueString(), data.Email.ValueString()))
+	var response iam.IAMOrganizationMembership
+	var err error
+	if data.Id.ValueString() == pendingInvitationId {
+		// Earlier versions of the provider stored pending invitations as
+		// memberships with this placeholder id
+		response, err = r.client.GetOrganizationMembershipByEmail(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+		if iam.IsNoMatch(err) {
+			resp.Diagnostics.AddWarning("PendingInvitationWarning",
+				fmt.Sprintf("The OrganizationMembership of the user with the e-mail %s in organization with id %s is a pending invitation, which is managed by the sys11iam_organization_invitation resource now. It is removed from the state, import the invitation with the id \"%s,%s\" to keep managing it.",
+					data.Email.ValueString(), data.OrganizationId.ValueString(), data.OrganizationId.ValueString(), data.Email.ValueString()))
+			resp.State.RemoveResource(ctx)
 			return
 		}
-		response, err := r.client.GetOrganizationMembershipByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
-		if err != nil {
-			// The email was neither invited, nor exists as a member
-			resp.Diagnostics.AddWarning("InvitationInexistentWarning",
-				fmt.Sprintf("Can not create OrganizationMembership in organization with id %s as the user with the e-mail %s was neither invited, nor exists as a member: %s",
-					data.OrganizationId.ValueString(), data.Email.ValueString(), err.Error()))
+	} else {
+		response, err = r.client.GetOrganizationMembership(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "OrganizationMembership not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
 			return
 		}
-		if response.ServiceAccount.ID != "" {
-			data.Id = types.StringValue(response.ServiceAccount.ID)
-		}
-		if response.User.ID != "" {
-			data.Id = types.StringValue(response.User.ID)
-		}
 	}
-	response, err := r.client.GetOrganizationMembership(data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -199,6 +274,14 @@ func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.
 	sort.Sort(sort.StringSlice(response.Permissions))
 	data.EditablePermissions, _ = types.ListValueFrom(ctx, types.StringType, response.Permissions)
 	data.IsActive = types.BoolValue(true)
+	// states written before wait_for_acceptance was added hold null, which
+	// would show up as an update to the default
+	if data.WaitForAcceptance.IsNull() {
+		data.WaitForAcceptance = types.BoolValue(false)
+	}
+	if data.Timeouts.IsNull() {
+		data.Timeouts = nullTimeouts()
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -231,16 +314,9 @@ func (r *OrganizationMembershipResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	if data.Id.ValueString() == "0" {
-		_, err := r.client.CreateOrganizationInvitation(data.OrganizationId.ValueString(), data.Email.ValueString(), elements)
-		if err != nil {
-			resp.Diagnostics.AddError("", err.Error())
-			return
-		}
-	}
-	response, err := r.client.UpdateOrganizationMembership(data.OrganizationId.ValueString(), data.Id.ValueString(), data.Affiliation.ValueString(), elements)
+	response, err := r.client.UpdateOrganizationMembership(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.Affiliation.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -273,20 +349,9 @@ func (r *OrganizationMembershipResource) Delete(ctx context.Context, req resourc
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting OrganizationMembership resource.")
-	if data.Id.ValueString() == "0" {
-		_, err := r.client.GetOrganizationInvitationByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
-		if err != nil {
-			return
-		}
-		err = r.client.DeleteOrganizationInvitation(data.OrganizationId.ValueString(), data.Email.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("", err.Error())
-			return
-		}
-	}
-	err := r.client.DeleteOrganizationMembership(data.OrganizationId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteOrganizationMembership(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -302,11 +367,19 @@ func (r *OrganizationMembershipResource) ImportState(ctx context.Context, req re
 		return
 	}
 
+	if idParts[1] == pendingInvitationId {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("%q is not a member id. Pending invitations are imported with the sys11iam_organization_invitation resource and the identifier format org_id,email.", req.ID),
+		)
+		return
+	}
+
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationMembership resource.")
-	response, err := r.client.GetOrganizationMembership(idParts[0], idParts[1])
+	response, err := r.client.GetOrganizationMembership(ctx, idParts[0], idParts[1])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -324,6 +397,8 @@ func (r *OrganizationMembershipResource) ImportState(ctx context.Context, req re
 	sort.Sort(sort.StringSlice(response.Permissions))
 	data.EditablePermissions, _ = types.ListValueFrom(ctx, types.StringType, response.Permissions)
 	data.IsActive = types.BoolValue(true)
+	data.WaitForAcceptance = types.BoolValue(false)
+	data.Timeouts = nullTimeouts()
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +19,10 @@ import (
 var _ resource.Resource = (*organizationResource)(nil)
 var _ resource.ResourceWithConfigure = (*organizationResource)(nil)
 
+// defaultActivationTimeout is the time to wait for the activation of a new
+// organization if the create timeout is not configured
+const defaultActivationTimeout = time.Hour
+
 func NewOrganizationResource() resource.Resource {
 	return &organizationResource{}
 }
@@ -71,9 +78,9 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	if data.Id.ValueString() != "" {
-		response, err := r.client.GetOrganization(data.Id.ValueString())
+		response, err := r.client.GetOrganization(ctx, data.Id.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("", err.Error())
+			addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 			return
 		}
 		// Data value setting
@@ -100,9 +107,9 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 				CompanyName:            data.CompanyInfoCompanyName.ValueString(),
 			},
 		}
-		response, err := r.client.CreateOrganization(iAMOrganization)
+		response, err := r.client.CreateOrganization(ctx, iAMOrganization)
 		if err != nil {
-			resp.Diagnostics.AddError("", err.Error())
+			addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 			return
 		}
 		// Data value setting
@@ -115,22 +122,64 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 		data.Tags, _ = types.ListValueFrom(ctx, types.StringType, response.Tags)
 	}
 
-	// Emit manual steps as warnings
-	if !data.IsActive.ValueBool() {
-		resp.Diagnostics.AddWarning("OrganizationNotActiveWarning",
-			fmt.Sprintf("Organization with id %s is not active. Organization activation is a manual step, please contact the SysEleven GmbH Sales Team <sales@syseleven.de>.\n This can also be done via https://dashboard.syseleven.de/dashboard.",
-				data.Id.ValueString()))
+	if data.WaitForActivation.ValueBool() {
+		r.waitForActivation(ctx, &data, &resp.Diagnostics)
 	} else {
-		resp.Diagnostics.AddWarning("OrganizationAlreadyActiveWarning",
-			fmt.Sprintf("Organization with id %s did already exist and is active. Please rerun terraform to create the resources depending on this organization.",
-				data.Id.ValueString()))
+		// Emit manual steps as warnings
+		if !data.IsActive.ValueBool() {
+			resp.Diagnostics.AddWarning("OrganizationNotActiveWarning",
+				fmt.Sprintf("Organization with id %s is not active. Organization activation is a manual step, please contact the SysEleven GmbH Sales Team <sales@syseleven.de>.\n This can also be done via https://dashboard.syseleven.de/dashboard.",
+					data.Id.ValueString()))
+		} else {
+			resp.Diagnostics.AddWarning("OrganizationAlreadyActiveWarning",
+				fmt.Sprintf("Organization with id %s did already exist and is active. Please rerun terraform to create the resources depending on this organization.",
+					data.Id.ValueString()))
+		}
+		data.IsActive = types.BoolValue(false)
 	}
-	data.IsActive = types.BoolValue(false)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// waitForActivation polls the organization until it is active. The
+// organization has been created already and its activation is a manual step,
+// so running into the timeout or failing to check the organization only adds
+// a warning. An error would taint the organization and replace it with the
+// next apply.
+func (r *organizationResource) waitForActivation(ctx context.Context, data *resource_organization.OrganizationModel, diagnostics *diag.Diagnostics) {
+	if data.IsActive.ValueBool() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultActivationTimeout)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for organization with id %s to be activated.", timeout, data.Id.ValueString()))
+	err := waitFor(ctx, timeout, func(ctx context.Context) (bool, error) {
+		response, err := r.client.GetOrganization(ctx, data.Id.ValueString())
+		if err != nil {
+			return false, err
+		}
+		data.IsActive = types.BoolValue(response.IsActive)
+		data.UpdatedAt = types.StringValue(response.UpdatedAt)
+		return response.IsActive, nil
+	})
+	if errors.Is(err, errWaitTimeout) {
+		diagnostics.AddWarning("OrganizationNotActiveWarning",
+			fmt.Sprintf("Organization with id %s has not been activated within %s. Organization activation is a manual step, please contact the SysEleven GmbH Sales Team <sales@syseleven.de>.\n This can also be done via https://dashboard.syseleven.de/dashboard. Rerun terraform once the organization has been activated.",
+				data.Id.ValueString(), timeout))
+		return
+	}
+	if err != nil {
+		diagnostics.AddWarning("OrganizationNotActiveWarning",
+			fmt.Sprintf("Could not check whether organization with id %s has been activated: %s. Rerun terraform once the organization has been activated.", data.Id.ValueString(), err.Error()))
+	}
+}
+
 func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data resource_organization.OrganizationModel
 
@@ -143,17 +192,15 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading organization resource.")
-	response, err := r.client.GetOrganizationByName(data.Name.ValueString())
+	response, err := r.client.GetOrganization(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
-		return
-	}
-	if response.Name != data.Name.ValueString() {
-		response, err = r.client.GetOrganization(data.Id.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "Organization not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
 			return
 		}
+		addClientError(&resp.Diagnostics, err)
+		return
 	}
 
 	// Data value setting
@@ -171,6 +218,15 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 				data.Id.ValueString()))
 	}
 
+	// states written before wait_for_activation was added hold null, which
+	// would show up as an update to the default
+	if data.WaitForActivation.IsNull() {
+		data.WaitForActivation = types.BoolValue(false)
+	}
+	if data.Timeouts.IsNull() {
+		data.Timeouts = nullTimeouts()
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -201,9 +257,9 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		Tags:        elements,
 	}
 
-	response, err := r.client.UpdateOrganization(data.Id.ValueString(), iAMOrganization)
+	response, err := r.client.UpdateOrganization(ctx, data.Id.ValueString(), iAMOrganization)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -231,9 +287,9 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	// Delete API call logic
 	tflog.Info(ctx, "Reading organization resource.")
-	err := r.client.DeleteOrganization(data.Id.ValueString())
+	err := r.client.DeleteOrganization(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -251,14 +307,16 @@ func (r *organizationResource) ImportState(ctx context.Context, req resource.Imp
 
 	// Read API call logic
 
-	response, err := r.client.GetOrganization(idParts[0])
+	response, err := r.client.GetOrganization(ctx, idParts[0])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
 	var data resource_organization.OrganizationModel
 	// Data value setting
+	data.WaitForActivation = types.BoolValue(false)
+	data.Timeouts = nullTimeouts()
 	data.Id = types.StringValue(idParts[0])
 	data.Name = types.StringValue(response.Name)
 	data.Description = types.StringValue(response.Description)
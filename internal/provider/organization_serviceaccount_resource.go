@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = (*OrganizationServiceaccountResource)(nil)
 var _ resource.ResourceWithConfigure = (*OrganizationServiceaccountResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*OrganizationServiceaccountResource)(nil)
 
 func NewOrganizationServiceaccountResource() resource.Resource {
 	return &OrganizationServiceaccountResource{}
@@ -51,6 +52,10 @@ func (r *OrganizationServiceaccountResource) Configure(_ context.Context, req re
 	r.client = client
 }
 
+func (r *OrganizationServiceaccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("permissions"), iam.OrganizationPermissionScope, &resp.Diagnostics)
+}
+
 func (r *OrganizationServiceaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_organization_serviceaccount.OrganizationServiceaccountModel
 
@@ -65,9 +70,9 @@ func (r *OrganizationServiceaccountResource) Create(ctx context.Context, req res
 	tflog.Info(ctx, "Creating OrganizationServiceaccount resource.")
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -77,12 +82,34 @@ func (r *OrganizationServiceaccountResource) Create(ctx context.Context, req res
 		return
 	}
 
-	response, err := r.client.CreateOrganizationServiceaccount(data.OrganizationId.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+	response, err := r.client.CreateOrganizationServiceaccount(ctx, data.OrganizationId.ValueString(), data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
+	// Permissions are only managed when they are configured
+	if !data.Permissions.IsNull() {
+		var permissions []string
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, "Creating OrganizationServiceaccount permissions.")
+		permissions, err = r.client.CreateOrganizationServiceaccountPermissions(ctx, data.OrganizationId.ValueString(), response.ID, permissions)
+		if err != nil {
+			// the service account exists, keep it in the state so it is not orphaned
+			data.Id = types.StringValue(response.ID)
+			data.Permissions = types.ListNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+			return
+		}
+		permissionList, diags := permissionsValue(ctx, data.Permissions, permissions)
+		resp.Diagnostics.Append(diags...)
+		data.Permissions = permissionList
+	}
+
 	// Data value setting
 	data.Id = types.StringValue(response.ID)
 	data.Name = types.StringValue(response.Name)
@@ -104,12 +131,29 @@ func (r *OrganizationServiceaccountResource) Read(ctx context.Context, req resou
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationServiceaccount resource.")
-	response, err := r.client.GetOrganizationServiceaccount(data.OrganizationId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetOrganizationServiceaccount(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "OrganizationServiceaccount not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
+	// Permissions are only read when they are managed
+	if !data.Permissions.IsNull() {
+		permissions, err := r.client.GetOrganizationServiceaccountPermissions(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+		if err != nil {
+			addClientError(&resp.Diagnostics, err)
+			return
+		}
+		permissionList, diags := permissionsValue(ctx, data.Permissions, permissions)
+		resp.Diagnostics.Append(diags...)
+		data.Permissions = permissionList
+	}
+
 	// Data value setting
 	data.Id = types.StringValue(response.ID)
 	data.Name = types.StringValue(response.Name)
@@ -126,18 +170,37 @@ func (r *OrganizationServiceaccountResource) Update(ctx context.Context, req res
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &data.Id)...)
 
+	var statePermissions types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("permissions"), &statePermissions)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Update API call logic
 	tflog.Info(ctx, "Updating OrganizationServiceaccount resource.")
-	response, err := r.client.UpdateOrganizationServiceaccount(data.OrganizationId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+	response, err := r.client.UpdateOrganizationServiceaccount(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
+	// Removing the permissions from the configuration stops managing them,
+	// an empty list removes all permissions
+	if !data.Permissions.IsNull() && !data.Permissions.Equal(statePermissions) {
+		var permissions []string
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, "Updating OrganizationServiceaccount permissions.")
+		_, err = r.client.UpdateOrganizationServiceaccountPermissions(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), permissions)
+		if err != nil {
+			addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+			return
+		}
+	}
+
 	// Data value setting
 	data.Id = types.StringValue(response.ID)
 	data.Name = types.StringValue(response.Name)
@@ -159,9 +222,9 @@ func (r *OrganizationServiceaccountResource) Delete(ctx context.Context, req res
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting OrganizationServiceaccount resource.")
-	err := r.client.DeleteOrganizationServiceaccount(data.OrganizationId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteOrganizationServiceaccount(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -179,9 +242,9 @@ func (r *OrganizationServiceaccountResource) ImportState(ctx context.Context, re
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationServiceaccount resource.")
-	response, err := r.client.GetOrganizationServiceaccount(idParts[0], idParts[1])
+	response, err := r.client.GetOrganizationServiceaccount(ctx, idParts[0], idParts[1])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -192,6 +255,8 @@ func (r *OrganizationServiceaccountResource) ImportState(ctx context.Context, re
 	data.OrganizationId = types.StringValue(idParts[0])
 	data.Name = types.StringValue(response.Name)
 	data.Description = types.StringValue(response.Description)
+	// Permissions are unmanaged until they are configured
+	data.Permissions = types.ListNull(types.StringType)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
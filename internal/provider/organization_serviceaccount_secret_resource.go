@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/resource_organization_serviceaccount_secret"
+)
+
+var _ resource.Resource = (*OrganizationServiceaccountSecretResource)(nil)
+var _ resource.ResourceWithConfigure = (*OrganizationServiceaccountSecretResource)(nil)
+
+func NewOrganizationServiceaccountSecretResource() resource.Resource {
+	return &OrganizationServiceaccountSecretResource{}
+}
+
+type OrganizationServiceaccountSecretResource struct {
+	client *iam.Client
+}
+
+func (r *OrganizationServiceaccountSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_serviceaccount_secret"
+}
+
+func (r *OrganizationServiceaccountSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_organization_serviceaccount_secret.OrganizationServiceaccountSecretResourceSchema(ctx)
+}
+
+func (r *OrganizationServiceaccountSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationServiceaccountSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_organization_serviceaccount_secret.OrganizationServiceaccountSecretModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	tflog.Info(ctx, "Creating OrganizationServiceaccountSecret resource.")
+	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
+	// Is the organization active?
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
+	if err != nil {
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+		return
+	}
+	if !org_response.IsActive {
+		resp.Diagnostics.AddError("OrganizationNotActiveError",
+			fmt.Sprintf("Can not create OrganizationServiceaccountSecret in organization with id %s as it is not active. Organization activation is a manual step, please contact an IAM administrator.",
+				data.OrganizationId.ValueString()))
+		return
+	}
+
+	response, err := r.client.CreateOrganizationServiceaccountSecret(ctx, data.OrganizationId.ValueString(), data.ServiceaccountId.ValueString())
+	if err != nil {
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+		return
+	}
+
+	// Data value setting
+	data.Id = types.StringValue(response.ID)
+	data.Secret = types.StringValue(response.Secret)
+	data.CreatedAt = types.StringValue(response.CreatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationServiceaccountSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_organization_serviceaccount_secret.OrganizationServiceaccountSecretModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading OrganizationServiceaccountSecret resource.")
+	response, err := r.client.GetOrganizationServiceaccountSecret(ctx, data.OrganizationId.ValueString(), data.ServiceaccountId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "OrganizationServiceaccountSecret not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting, the secret itself is only returned on creation and kept from the state
+	data.Id = types.StringValue(response.ID)
+	data.CreatedAt = types.StringValue(response.CreatedAt)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationServiceaccountSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_organization_serviceaccount_secret.OrganizationServiceaccountSecretModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	tflog.Info(ctx, "OrganizationServiceaccountSecret can't be updated. Passing in unchanged state.")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationServiceaccountSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_organization_serviceaccount_secret.OrganizationServiceaccountSecretModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	tflog.Info(ctx, "Revoking OrganizationServiceaccountSecret resource.")
+	err := r.client.DeleteOrganizationServiceaccountSecret(ctx, data.OrganizationId.ValueString(), data.ServiceaccountId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+}
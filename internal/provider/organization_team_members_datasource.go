@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_team_members"
+)
+
+var (
+	_ datasource.DataSource              = &organizationTeamMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationTeamMembersDataSource{}
+)
+
+func NewOrganizationTeamMembersDataSource() datasource.DataSource {
+	return &organizationTeamMembersDataSource{}
+}
+
+type organizationTeamMembersDataSource struct {
+	client *iam.Client
+}
+
+func (r *organizationTeamMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_team_members"
+}
+
+func (r *organizationTeamMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_team_members.OrganizationTeamMembersDataSourceSchema(ctx)
+}
+
+func (r *organizationTeamMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationTeamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_organization_team_members.OrganizationTeamMembersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading organization team members datasource.")
+	response, err := r.client.ListOrganizationTeamMemberships(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	members := []datasource_organization_team_members.OrganizationTeamMemberModel{}
+	for _, membership := range response {
+		sort.Sort(sort.StringSlice(membership.TeamPermissions))
+		sort.Sort(sort.StringSlice(membership.OrganizationPermissions))
+		teamPermissions, diags := types.ListValueFrom(ctx, types.StringType, membership.TeamPermissions)
+		resp.Diagnostics.Append(diags...)
+		organizationPermissions, diags := types.ListValueFrom(ctx, types.StringType, membership.OrganizationPermissions)
+		resp.Diagnostics.Append(diags...)
+		members = append(members, datasource_organization_team_members.OrganizationTeamMemberModel{
+			MembershipType:          types.StringValue(membership.MembershipType),
+			UserId:                  stringOrNull(membership.User.ID),
+			UserEmail:               stringOrNull(membership.User.Email),
+			UserName:                stringOrNull(membership.User.Name),
+			ServiceAccountId:        stringOrNull(membership.ServiceAccount.ID),
+			ServiceAccountName:      stringOrNull(membership.ServiceAccount.Name),
+			TeamPermissions:         teamPermissions,
+			OrganizationPermissions: organizationPermissions,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].UserEmail.ValueString() != members[j].UserEmail.ValueString() {
+			return members[i].UserEmail.ValueString() < members[j].UserEmail.ValueString()
+		}
+		return members[i].ServiceAccountName.ValueString() < members[j].ServiceAccountName.ValueString()
+	})
+
+	memberList, diags := types.ListValueFrom(ctx, datasource_organization_team_members.OrganizationTeamMemberType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = memberList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
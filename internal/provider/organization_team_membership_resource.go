@@ -67,9 +67,9 @@ func (r *OrganizationTeamMembershipResource) Create(ctx context.Context, req res
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -79,9 +79,9 @@ func (r *OrganizationTeamMembershipResource) Create(ctx context.Context, req res
 		return
 	}
 
-	_, err = r.client.CreateOrganizationTeamMembership(data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
+	_, err = r.client.CreateOrganizationTeamMembership(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -104,9 +104,14 @@ func (r *OrganizationTeamMembershipResource) Read(ctx context.Context, req resou
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationTeamMembership resource.")
-	response, err := r.client.GetOrganizationTeamMembership(data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetOrganizationTeamMembership(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "OrganizationTeamMembership not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -131,9 +136,9 @@ func (r *OrganizationTeamMembershipResource) Update(ctx context.Context, req res
 	// Update API call logic
 	tflog.Info(ctx, "Updating OrganizationTeamMembership resource.")
 
-	response, err := r.client.UpdateOrganizationTeamMembership(data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
+	response, err := r.client.UpdateOrganizationTeamMembership(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -156,9 +161,9 @@ func (r *OrganizationTeamMembershipResource) Delete(ctx context.Context, req res
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting OrganizationTeamMembership resource.")
-	err := r.client.DeleteOrganizationTeamMembership(data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteOrganizationTeamMembership(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -176,9 +181,9 @@ func (r *OrganizationTeamMembershipResource) ImportState(ctx context.Context, re
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationTeamMembership resource.")
-	response, err := r.client.GetOrganizationTeamMembership(idParts[0], idParts[1], idParts[2])
+	response, err := r.client.GetOrganizationTeamMembership(ctx, idParts[0], idParts[1], idParts[2])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = (*OrganizationTeamResource)(nil)
 var _ resource.ResourceWithConfigure = (*OrganizationTeamResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*OrganizationTeamResource)(nil)
 
 func NewOrganizationTeamResource() resource.Resource {
 	return &OrganizationTeamResource{}
@@ -52,6 +53,10 @@ func (r *OrganizationTeamResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
+func (r *OrganizationTeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("editable_permissions"), iam.OrganizationPermissionScope, &resp.Diagnostics)
+}
+
 func (r *OrganizationTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_organization_team.OrganizationTeamModel
 
@@ -67,9 +72,9 @@ func (r *OrganizationTeamResource) Create(ctx context.Context, req resource.Crea
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -86,9 +91,9 @@ func (r *OrganizationTeamResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	response, err := r.client.CreateOrganizationTeam(data.OrganizationId.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
+	response, err := r.client.CreateOrganizationTeam(ctx, data.OrganizationId.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -112,9 +117,14 @@ func (r *OrganizationTeamResource) Read(ctx context.Context, req resource.ReadRe
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationTeam resource.")
-	response, err := r.client.GetOrganizationTeam(data.OrganizationId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetOrganizationTeam(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "OrganizationTeam not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -147,9 +157,9 @@ func (r *OrganizationTeamResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	response, err := r.client.UpdateOrganizationTeam(data.OrganizationId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
+	response, err := r.client.UpdateOrganizationTeam(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -174,9 +184,9 @@ func (r *OrganizationTeamResource) Delete(ctx context.Context, req resource.Dele
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting OrganizationTeam resource.")
-	err := r.client.DeleteOrganizationTeam(data.OrganizationId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteOrganizationTeam(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -194,15 +204,15 @@ func (r *OrganizationTeamResource) ImportState(ctx context.Context, req resource
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading OrganizationTeam resource.")
-	response, err := r.client.GetOrganizationTeam(idParts[0], idParts[1])
+	response, err := r.client.GetOrganizationTeam(ctx, idParts[0], idParts[1])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
-	response_permissions, err := r.client.GetOrganizationTeamPermissions(idParts[0], idParts[1])
+	response_permissions, err := r.client.GetOrganizationTeamPermissions(ctx, idParts[0], idParts[1])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organization_teams"
+)
+
+var (
+	_ datasource.DataSource              = &organizationTeamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationTeamsDataSource{}
+)
+
+func NewOrganizationTeamsDataSource() datasource.DataSource {
+	return &organizationTeamsDataSource{}
+}
+
+type organizationTeamsDataSource struct {
+	client *iam.Client
+}
+
+func (r *organizationTeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_teams"
+}
+
+func (r *organizationTeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organization_teams.OrganizationTeamsDataSourceSchema(ctx)
+}
+
+func (r *organizationTeamsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationTeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_organization_teams.OrganizationTeamsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading organization teams datasource.")
+	organizationId := data.OrganizationId.ValueString()
+	response, err := r.client.ListOrganizationTeams(ctx, organizationId, nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	teams := []datasource_organization_teams.OrganizationTeamModel{}
+	for _, team := range response {
+		if !data.Tag.IsNull() && !slices.Contains(team.Tags, data.Tag.ValueString()) {
+			continue
+		}
+
+		// the list does not contain the permissions, they are fetched per team
+		teamPermissions, err := r.client.GetOrganizationTeamPermissions(ctx, organizationId, team.ID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, err)
+			return
+		}
+
+		sort.Sort(sort.StringSlice(team.Tags))
+		sort.Sort(sort.StringSlice(teamPermissions.TeamPermissions))
+		tags, diags := types.ListValueFrom(ctx, types.StringType, team.Tags)
+		resp.Diagnostics.Append(diags...)
+		permissions, diags := types.ListValueFrom(ctx, types.StringType, teamPermissions.TeamPermissions)
+		resp.Diagnostics.Append(diags...)
+		teams = append(teams, datasource_organization_teams.OrganizationTeamModel{
+			Id:          types.StringValue(team.ID),
+			Name:        types.StringValue(team.Name),
+			Description: types.StringValue(team.Description),
+			Tags:        tags,
+			Permissions: permissions,
+		})
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		return teams[i].Name.ValueString() < teams[j].Name.ValueString()
+	})
+
+	teamList, diags := types.ListValueFrom(ctx, datasource_organization_teams.OrganizationTeamType, teams)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Teams = teamList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_organizations"
+)
+
+var (
+	_ datasource.DataSource              = &organizationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationsDataSource{}
+)
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &organizationsDataSource{}
+}
+
+type organizationsDataSource struct {
+	client *iam.Client
+}
+
+func (r *organizationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+func (r *organizationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_organizations.OrganizationsDataSourceSchema(ctx)
+}
+
+func (r *organizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *organizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_organizations.OrganizationsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading organizations datasource.")
+	response, err := r.client.ListOrganizations(ctx, nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	organizations := []datasource_organizations.OrganizationModel{}
+	for _, org := range response {
+		if nameRegex != nil && !nameRegex.MatchString(org.Name) {
+			continue
+		}
+		if !data.Tag.IsNull() && !slices.Contains(org.Tags, data.Tag.ValueString()) {
+			continue
+		}
+		if !data.IsActive.IsNull() && data.IsActive.ValueBool() != org.IsActive {
+			continue
+		}
+
+		tags, diags := types.ListValueFrom(ctx, types.StringType, org.Tags)
+		resp.Diagnostics.Append(diags...)
+		organizations = append(organizations, datasource_organizations.OrganizationModel{
+			Id:                                types.StringValue(org.ID),
+			Name:                              types.StringValue(org.Name),
+			Description:                       types.StringValue(org.Description),
+			Tags:                              tags,
+			IsActive:                          types.BoolValue(org.IsActive),
+			CreatedAt:                         types.StringValue(org.CreatedAt),
+			UpdatedAt:                         types.StringValue(org.UpdatedAt),
+			CompanyInfoStreet:                 types.StringValue(org.CompanyInfo.Street),
+			CompanyInfoStreetNumber:           types.StringValue(org.CompanyInfo.StreetNumber),
+			CompanyInfoZipCode:                types.StringValue(org.CompanyInfo.ZipCode),
+			CompanyInfoCity:                   types.StringValue(org.CompanyInfo.City),
+			CompanyInfoCountry:                types.StringValue(org.CompanyInfo.Country),
+			CompanyInfoVatID:                  types.StringValue(org.CompanyInfo.VatID),
+			CompanyInfoPreferredBillingMethod: types.StringValue(org.CompanyInfo.PreferredBillingMethod),
+			CompanyInfoPhone:                  types.StringValue(org.CompanyInfo.Phone),
+			CompanyInfoAcceptedTos:            types.BoolValue(org.CompanyInfo.AcceptedTos),
+			CompanyInfoCompanyName:            types.StringValue(org.CompanyInfo.CompanyName),
+		})
+	}
+	sort.Slice(organizations, func(i, j int) bool {
+		return organizations[i].Name.ValueString() < organizations[j].Name.ValueString()
+	})
+
+	organizationList, diags := types.ListValueFrom(ctx, datasource_organizations.OrganizationType, organizations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Organizations = organizationList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
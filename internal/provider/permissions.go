@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+)
+
+// validatePermissions checks the permission list at attribute of the plan
+// against the permission catalog of the iam service, so typos are reported
+// at plan time instead of by the API during apply. Unknown values are skipped
+// and validation is skipped if the catalog is not available. The warning is
+// only added for the first resource, while the client remembers the failure.
+func validatePermissions(ctx context.Context, client *iam.Client, plan tfsdk.Plan, attribute path.Path, scope string, diagnostics *diag.Diagnostics) {
+	// nothing to validate when the resource is destroyed or the provider
+	// has not been configured yet
+	if plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	var permissions types.List
+	diagnostics.Append(plan.GetAttribute(ctx, attribute, &permissions)...)
+	if diagnostics.HasError() || permissions.IsNull() || permissions.IsUnknown() {
+		return
+	}
+
+	catalog, err := client.GetPermissionCatalog(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Permission catalog is not available, skipping validation.", map[string]interface{}{"error": err.Error()})
+		if errors.Is(err, iam.ErrPermissionCatalogUnavailable) {
+			// the warning has been shown for the resource that ran into the failure
+			return
+		}
+		diagnostics.AddAttributeWarning(attribute, "PermissionValidationSkippedWarning",
+			fmt.Sprintf("The %s permissions could not be validated, because the permission catalog is not available: %s", scope, err.Error()))
+		return
+	}
+	validPermissions := iam.PermissionNames(catalog, scope)
+	if len(validPermissions) == 0 {
+		// an empty catalog would reject every permission
+		tflog.Warn(ctx, "Permission catalog does not contain any permissions of the scope, skipping validation.", map[string]interface{}{"scope": scope})
+		return
+	}
+
+	for index, element := range permissions.Elements() {
+		permission, ok := element.(types.String)
+		if !ok || permission.IsNull() || permission.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(validPermissions, permission.ValueString()) {
+			diagnostics.AddAttributeError(attribute.AtListIndex(index), "InvalidPermissionError",
+				fmt.Sprintf("%q is not a valid %s permission. Valid %s permissions are: %s.",
+					permission.ValueString(), scope, scope, strings.Join(validPermissions, ", ")))
+		}
+	}
+}
+
+// permissionsValue returns the permissions read from the API as a list value.
+// The API does not keep the order of the permissions, so current is returned
+// unchanged when it holds the same permissions to avoid needless diffs.
+func permissionsValue(ctx context.Context, current types.List, permissions []string) (types.List, diag.Diagnostics) {
+	sorted := slices.Clone(permissions)
+	sort.Sort(sort.StringSlice(sorted))
+
+	if !current.IsNull() && !current.IsUnknown() {
+		elements := make([]string, 0, len(current.Elements()))
+		diags := current.ElementsAs(ctx, &elements, false)
+		if !diags.HasError() {
+			sort.Sort(sort.StringSlice(elements))
+			if slices.Equal(elements, sorted) {
+				return current, nil
+			}
+		}
+	}
+	return types.ListValueFrom(ctx, types.StringType, sorted)
+}
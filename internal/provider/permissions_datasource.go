@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_permissions"
+)
+
+var (
+	_ datasource.DataSource              = &permissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &permissionsDataSource{}
+)
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+type permissionsDataSource struct {
+	client *iam.Client
+}
+
+func (r *permissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (r *permissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_permissions.PermissionsDataSourceSchema(ctx)
+}
+
+func (r *permissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *permissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_permissions.PermissionsModel
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading permissions datasource.")
+	response, err := r.client.GetPermissionCatalog(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	permissions := []datasource_permissions.PermissionModel{}
+	for _, permission := range response {
+		permissions = append(permissions, datasource_permissions.PermissionModel{
+			Name:        types.StringValue(permission.Name),
+			Scope:       types.StringValue(permission.Scope),
+			Description: types.StringValue(permission.Description),
+		})
+	}
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Scope.ValueString() != permissions[j].Scope.ValueString() {
+			return permissions[i].Scope.ValueString() < permissions[j].Scope.ValueString()
+		}
+		return permissions[i].Name.ValueString() < permissions[j].Name.ValueString()
+	})
+
+	organizationPermissions := iam.PermissionNames(response, iam.OrganizationPermissionScope)
+	projectPermissions := iam.PermissionNames(response, iam.ProjectPermissionScope)
+	sort.Sort(sort.StringSlice(organizationPermissions))
+	sort.Sort(sort.StringSlice(projectPermissions))
+
+	var diags diag.Diagnostics
+	data.OrganizationPermissions, diags = types.ListValueFrom(ctx, types.StringType, organizationPermissions)
+	resp.Diagnostics.Append(diags...)
+	data.ProjectPermissions, diags = types.ListValueFrom(ctx, types.StringType, projectPermissions)
+	resp.Diagnostics.Append(diags...)
+	data.Permissions, diags = types.ListValueFrom(ctx, datasource_permissions.PermissionType, permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_project"
+)
+
+var (
+	_ datasource.DataSource                     = &projectDataSource{}
+	_ datasource.DataSourceWithConfigure        = &projectDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &projectDataSource{}
+)
+
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+type projectDataSource struct {
+	client *iam.Client
+}
+
+func (r *projectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *projectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_project.ProjectDataSourceSchema(ctx)
+}
+
+func (r *projectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (r *projectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_project.ProjectModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading project datasource.")
+	var response iam.IAMProject
+	var err error
+	if !data.Id.IsNull() {
+		response, err = r.client.GetProject(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	} else {
+		response, err = r.client.GetProjectByName(ctx, data.OrganizationId.ValueString(), data.Name.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+	if !data.Name.IsNull() && data.Name.ValueString() != response.Name {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "ProjectNameMismatchError",
+			fmt.Sprintf("Project with id %s is named %q, not %q.", response.ID, response.Name, data.Name.ValueString()))
+		return
+	}
+
+	// Data value setting
+	data.Id = types.StringValue(response.ID)
+	data.Name = types.StringValue(response.Name)
+	data.Description = types.StringValue(response.Description)
+	data.Status = types.StringValue(response.Status)
+	data.CreatedAt = types.StringValue(response.CreatedAt)
+	data.UpdatedAt = types.StringValue(response.UpdatedAt)
+	tags, diags := types.ListValueFrom(ctx, types.StringType, response.Tags)
+	resp.Diagnostics.Append(diags...)
+	data.Tags = tags
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_project_members"
+)
+
+var (
+	_ datasource.DataSource              = &projectMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectMembersDataSource{}
+)
+
+func NewProjectMembersDataSource() datasource.DataSource {
+	return &projectMembersDataSource{}
+}
+
+type projectMembersDataSource struct {
+	client *iam.Client
+}
+
+func (r *projectMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_members"
+}
+
+func (r *projectMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_project_members.ProjectMembersDataSourceSchema(ctx)
+}
+
+func (r *projectMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_project_members.ProjectMembersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading project members datasource.")
+	response, err := r.client.ListProjectMemberships(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	members := []datasource_project_members.ProjectMemberModel{}
+	for _, membership := range response {
+		if !data.MembershipType.IsNull() && data.MembershipType.ValueString() != membership.MembershipType {
+			continue
+		}
+		if !data.Permission.IsNull() && !slices.Contains(membership.Permissions, data.Permission.ValueString()) {
+			continue
+		}
+
+		sort.Sort(sort.StringSlice(membership.Permissions))
+		permissions, diags := types.ListValueFrom(ctx, types.StringType, membership.Permissions)
+		resp.Diagnostics.Append(diags...)
+		members = append(members, datasource_project_members.ProjectMemberModel{
+			MembershipType:     types.StringValue(membership.MembershipType),
+			UserId:             stringOrNull(membership.User.ID),
+			UserEmail:          stringOrNull(membership.User.Email),
+			UserName:           stringOrNull(membership.User.Name),
+			ServiceAccountId:   stringOrNull(membership.ServiceAccount.ID),
+			ServiceAccountName: stringOrNull(membership.ServiceAccount.Name),
+			Permissions:        permissions,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].UserEmail.ValueString() != members[j].UserEmail.ValueString() {
+			return members[i].UserEmail.ValueString() < members[j].UserEmail.ValueString()
+		}
+		return members[i].ServiceAccountName.ValueString() < members[j].ServiceAccountName.ValueString()
+	})
+
+	memberList, diags := types.ListValueFrom(ctx, datasource_project_members.ProjectMemberType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = memberList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
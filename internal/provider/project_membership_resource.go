@@ -16,6 +16,7 @@ import (
 
 var _ resource.Resource = (*ProjectMembershipResource)(nil)
 var _ resource.ResourceWithConfigure = (*ProjectMembershipResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ProjectMembershipResource)(nil)
 
 func NewProjectMembershipResource() resource.Resource {
 	return &ProjectMembershipResource{}
@@ -52,6 +53,10 @@ func (r *ProjectMembershipResource) Configure(_ context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *ProjectMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("permissions"), iam.ProjectPermissionScope, &resp.Diagnostics)
+}
+
 func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_project_membership.ProjectMembershipModel
 
@@ -66,9 +71,9 @@ func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.Cre
 	tflog.Info(ctx, "Creating ProjectMembership resource.")
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -86,22 +91,16 @@ func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Is the e-mail already a member?
-	org_membership_response, err := r.client.GetOrganizationMembershipByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
+	org_membership_response, err := r.client.GetOrganizationMembershipByEmail(ctx, data.OrganizationId.ValueString(), data.Email.ValueString())
+	if err != nil && !iam.IsNoMatch(err) {
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
+		return
+	}
 	if err != nil {
-		// Is the e-mail at least invited?
-		_, err := r.client.GetOrganizationInvitationByEmail(data.OrganizationId.ValueString(), data.Email.ValueString())
-		if err != nil {
-			// Invite the e-mail
-			_, err := r.client.CreateOrganizationInvitation(data.OrganizationId.ValueString(), data.Email.ValueString(), elements)
-			if err != nil {
-				resp.Diagnostics.AddError("", err.Error())
-				return
-			}
-		}
-		// The email is invited, but has to be activated manually
-		resp.Diagnostics.AddError("InvitationNotAcceptedError",
-			fmt.Sprintf("Can not create ProjectMembership in project with id %s in organization with id %s as the user with the e-mail %s has not yet accepted the invitation. Invitation accepting is a manual step, please contact the invited user.",
-				data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Email.ValueString()))
+		// Users have to be invited to the organization and accept the invitation first
+		resp.Diagnostics.AddError("MemberNotFoundError",
+			fmt.Sprintf("Can not create ProjectMembership in project with id %s in organization with id %s as the user with the e-mail %s is not a member of the organization. Invite the user with the sys11iam_organization_invitation resource and create the ProjectMembership once the invitation has been accepted, e.g. by depending on a sys11iam_organization_membership with wait_for_acceptance.",
+				data.ProjectId.ValueString(), data.OrganizationId.ValueString(), data.Email.ValueString()))
 		return
 	}
 	if org_membership_response.ServiceAccount.ID != "" {
@@ -111,9 +110,9 @@ func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.Cre
 		data.Id = types.StringValue(org_membership_response.User.ID)
 	}
 
-	response, err := r.client.CreateProjectMembership(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString(), elements)
+	response, err := r.client.CreateProjectMembership(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -145,9 +144,14 @@ func (r *ProjectMembershipResource) Read(ctx context.Context, req resource.ReadR
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectMembership resource.")
-	response, err := r.client.GetProjectMembership(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetProjectMembership(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "ProjectMembership not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -194,9 +198,9 @@ func (r *ProjectMembershipResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	response, err := r.client.UpdateProjectMembership(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString(), elements)
+	response, err := r.client.UpdateProjectMembership(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -228,9 +232,9 @@ func (r *ProjectMembershipResource) Delete(ctx context.Context, req resource.Del
 
 	// Delete API call logic
 	tflog.Info(ctx, "Reading ProjectMembership resource.")
-	err := r.client.DeleteProjectMembership(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteProjectMembership(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -248,9 +252,9 @@ func (r *ProjectMembershipResource) ImportState(ctx context.Context, req resourc
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectMembership resource.")
-	response, err := r.client.GetProjectMembership(idParts[0], idParts[1], idParts[2])
+	response, err := r.client.GetProjectMembership(ctx, idParts[0], idParts[1], idParts[2])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
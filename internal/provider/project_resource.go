@@ -64,9 +64,9 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create API call logic
 	tflog.Info(ctx, "Creating Project resource.")
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -82,9 +82,9 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	response, err := r.client.CreateProject(data.OrganizationId.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
+	response, err := r.client.CreateProject(ctx, data.OrganizationId.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -110,9 +110,14 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading Project resource.")
-	response, err := r.client.GetProject(data.OrganizationId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetProject(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "Project not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -154,9 +159,9 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	_, err := r.client.UpdateProject(data.OrganizationId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
+	_, err := r.client.UpdateProject(ctx, data.OrganizationId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -179,9 +184,9 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	// Delete API call logic
 	tflog.Info(ctx, "Reading Project resource.")
-	err := r.client.DeleteProject(data.OrganizationId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteProject(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -199,9 +204,9 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading Project resource.")
-	response, err := r.client.GetProject(idParts[0], idParts[1])
+	response, err := r.client.GetProject(ctx, idParts[0], idParts[1])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -64,9 +64,9 @@ func (r *ProjectS3UserKeyResource) Create(ctx context.Context, req resource.Crea
 	tflog.Info(ctx, "Creating S3User key Resource")
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -76,9 +76,9 @@ func (r *ProjectS3UserKeyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	response, err := r.client.CreateProjectS3UserKey(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.S3UserId.ValueString())
+	response, err := r.client.CreateProjectS3UserKey(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.S3UserId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -102,9 +102,14 @@ func (r *ProjectS3UserKeyResource) Read(ctx context.Context, req resource.ReadRe
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectS3User resource.")
-	response, err := r.client.GetProjectS3UserKey(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.S3UserId.ValueString(), data.S3AccessKey.ValueString())
+	response, err := r.client.GetProjectS3UserKey(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.S3UserId.ValueString(), data.S3AccessKey.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "ProjectS3UserKey not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -130,9 +135,9 @@ func (r *ProjectS3UserKeyResource) ImportState(ctx context.Context, req resource
 
 	// Read API Call logic
 	tflog.Info(ctx, "Reading ProjectS3UserKey resource.")
-	response, err := r.client.GetProjectS3UserKey(idParts[0], idParts[1], idParts[2], idParts[3])
+	response, err := r.client.GetProjectS3UserKey(ctx, idParts[0], idParts[1], idParts[2], idParts[3])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -161,9 +166,9 @@ func (r *ProjectS3UserKeyResource) Delete(ctx context.Context, req resource.Dele
 	// Delete the S3User key
 	tflog.Info(ctx, "Deleting S3User key Resource")
 
-	err := r.client.DeleteProjectS3UserKey(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.S3UserId.ValueString(), data.S3AccessKey.ValueString())
+	err := r.client.DeleteProjectS3UserKey(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.S3UserId.ValueString(), data.S3AccessKey.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -66,9 +66,9 @@ func (r *ProjectS3UserResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -78,9 +78,9 @@ func (r *ProjectS3UserResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	response, err := r.client.CreateProjectS3User(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+	response, err := r.client.CreateProjectS3User(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -102,9 +102,14 @@ func (r *ProjectS3UserResource) Read(ctx context.Context, req resource.ReadReque
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectS3User resource.")
-	response, err := r.client.GetProjectS3User(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetProjectS3User(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "ProjectS3User not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -129,9 +134,9 @@ func (r *ProjectS3UserResource) Update(ctx context.Context, req resource.UpdateR
 	// Update API call logic
 	tflog.Info(ctx, "Updating ProjectS3User resource.")
 
-	response, err := r.client.UpdateProjectS3User(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+	response, err := r.client.UpdateProjectS3User(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -154,9 +159,9 @@ func (r *ProjectS3UserResource) Delete(ctx context.Context, req resource.DeleteR
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting ProjectS3User resource.")
-	err := r.client.DeleteProjectS3User(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteProjectS3User(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -174,9 +179,9 @@ func (r *ProjectS3UserResource) ImportState(ctx context.Context, req resource.Im
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectS3User resource.")
-	response, err := r.client.GetProjectS3User(idParts[0], idParts[1], idParts[2])
+	response, err := r.client.GetProjectS3User(ctx, idParts[0], idParts[1], idParts[2])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
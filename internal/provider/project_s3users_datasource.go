@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_project_s3users"
+)
+
+var (
+	_ datasource.DataSource              = &projectS3usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectS3usersDataSource{}
+)
+
+func NewProjectS3usersDataSource() datasource.DataSource {
+	return &projectS3usersDataSource{}
+}
+
+type projectS3usersDataSource struct {
+	client *iam.Client
+}
+
+func (r *projectS3usersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_s3users"
+}
+
+func (r *projectS3usersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_project_s3users.ProjectS3usersDataSourceSchema(ctx)
+}
+
+func (r *projectS3usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectS3usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_project_s3users.ProjectS3usersModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading project s3users datasource.")
+	response, err := r.client.ListProjectS3Users(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	s3users := []datasource_project_s3users.ProjectS3userModel{}
+	for _, s3user := range response {
+		// only the metadata of the keys is exposed, the secret keys must not
+		// end up in the state of a data source
+		keys := []datasource_project_s3users.ProjectS3userKeyModel{}
+		for _, key := range s3user.Keys {
+			keys = append(keys, datasource_project_s3users.ProjectS3userKeyModel{
+				AccessKey: types.StringValue(key.AccessKey),
+				CreatedAt: types.StringValue(key.CreatedAt),
+			})
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].CreatedAt.ValueString() < keys[j].CreatedAt.ValueString()
+		})
+
+		keyList, diags := types.ListValueFrom(ctx, datasource_project_s3users.ProjectS3userKeyType, keys)
+		resp.Diagnostics.Append(diags...)
+		s3users = append(s3users, datasource_project_s3users.ProjectS3userModel{
+			Id:          types.StringValue(s3user.ID),
+			Name:        types.StringValue(s3user.Name),
+			Description: types.StringValue(s3user.Description),
+			Keys:        keyList,
+		})
+	}
+	sort.Slice(s3users, func(i, j int) bool {
+		return s3users[i].Name.ValueString() < s3users[j].Name.ValueString()
+	})
+
+	s3userList, diags := types.ListValueFrom(ctx, datasource_project_s3users.ProjectS3userType, s3users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.S3users = s3userList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
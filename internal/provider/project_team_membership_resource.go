@@ -15,6 +15,7 @@ import (
 
 var _ resource.Resource = (*ProjectTeamMembershipResource)(nil)
 var _ resource.ResourceWithConfigure = (*ProjectTeamMembershipResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ProjectTeamMembershipResource)(nil)
 
 func NewProjectTeamMembershipResource() resource.Resource {
 	return &ProjectTeamMembershipResource{}
@@ -51,6 +52,10 @@ func (r *ProjectTeamMembershipResource) Configure(_ context.Context, req resourc
 	r.client = client
 }
 
+func (r *ProjectTeamMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("editable_permissions"), iam.ProjectPermissionScope, &resp.Diagnostics)
+}
+
 func (r *ProjectTeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_project_team_membership.ProjectTeamMembershipModel
 
@@ -66,9 +71,9 @@ func (r *ProjectTeamMembershipResource) Create(ctx context.Context, req resource
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -85,9 +90,9 @@ func (r *ProjectTeamMembershipResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	response, err := r.client.CreateProjectTeamMembership(data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString(), data.Id.ValueString(), elements)
+	response, err := r.client.CreateProjectTeamMembership(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString(), data.Id.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -110,9 +115,14 @@ func (r *ProjectTeamMembershipResource) Read(ctx context.Context, req resource.R
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectTeamMembership resource.")
-	response, err := r.client.GetProjectTeamMembership(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
+	response, err := r.client.GetProjectTeamMembership(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "ProjectTeamMembership not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -144,9 +154,9 @@ func (r *ProjectTeamMembershipResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	response, err := r.client.UpdateProjectTeamMembership(data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString(), data.Id.ValueString(), elements)
+	response, err := r.client.UpdateProjectTeamMembership(ctx, data.OrganizationId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString(), data.Id.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -170,9 +180,9 @@ func (r *ProjectTeamMembershipResource) Delete(ctx context.Context, req resource
 
 	// Delete API call logic
 	tflog.Info(ctx, "Deleting ProjectTeamMembership resource.")
-	err := r.client.DeleteProjectTeamMembership(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteProjectTeamMembership(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -15,6 +16,7 @@ import (
 
 var _ resource.Resource = (*ProjectTeamResource)(nil)
 var _ resource.ResourceWithConfigure = (*ProjectTeamResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ProjectTeamResource)(nil)
 
 func NewProjectTeamResource() resource.Resource {
 	return &ProjectTeamResource{}
@@ -51,6 +53,10 @@ func (r *ProjectTeamResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *ProjectTeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validatePermissions(ctx, r.client, req.Plan, path.Root("editable_permissions"), iam.ProjectPermissionScope, &resp.Diagnostics)
+}
+
 func (r *ProjectTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resource_project_team.ProjectTeamModel
 
@@ -65,9 +71,9 @@ func (r *ProjectTeamResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Info(ctx, "Creating ProjectTeam resource.")
 	tflog.Info(ctx, fmt.Sprintf("Checking if organization with id %s is active.", data.OrganizationId.ValueString()))
 	// Is the organization active?
-	org_response, err := r.client.GetOrganization(data.OrganizationId.ValueString())
+	org_response, err := r.client.GetOrganization(ctx, data.OrganizationId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 	if !org_response.IsActive {
@@ -84,9 +90,9 @@ func (r *ProjectTeamResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	_, err = r.client.CreateProjectTeamPermissions(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), elements)
+	_, err = r.client.CreateProjectTeamPermissions(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -108,9 +114,14 @@ func (r *ProjectTeamResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectTeam resource.")
-	response, err := r.client.GetProjectTeamPermissions(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString())
+	response, err := r.client.GetProjectTeamPermissions(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		if iam.IsNotFound(err) {
+			tflog.Warn(ctx, "ProjectTeam not found, removing it from state.")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
@@ -140,9 +151,9 @@ func (r *ProjectTeamResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	_, err := r.client.UpdateProjectTeamPermissions(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), elements)
+	_, err := r.client.UpdateProjectTeamPermissions(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString(), elements)
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addPlanClientError(ctx, &resp.Diagnostics, req.Plan, err)
 		return
 	}
 
@@ -164,9 +175,9 @@ func (r *ProjectTeamResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	// Delete API call logic
 	tflog.Info(ctx, "Reading ProjectTeam resource.")
-	err := r.client.DeleteProjectTeamPermissions(data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString())
+	err := r.client.DeleteProjectTeamPermissions(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.TeamId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 }
@@ -184,9 +195,9 @@ func (r *ProjectTeamResource) ImportState(ctx context.Context, req resource.Impo
 
 	// Read API call logic
 	tflog.Info(ctx, "Reading ProjectTeam resource.")
-	response, err := r.client.GetProjectTeamPermissions(idParts[0], idParts[1], idParts[2])
+	response, err := r.client.GetProjectTeamPermissions(ctx, idParts[0], idParts[1], idParts[2])
 	if err != nil {
-		resp.Diagnostics.AddError("", err.Error())
+		addClientError(&resp.Diagnostics, err)
 		return
 	}
 
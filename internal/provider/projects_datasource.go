@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/datasource_projects"
+)
+
+var (
+	_ datasource.DataSource              = &projectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectsDataSource{}
+)
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+type projectsDataSource struct {
+	client *iam.Client
+}
+
+func (r *projectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (r *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_projects.ProjectsDataSourceSchema(ctx)
+}
+
+func (r *projectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*iam.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *iam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_projects.ProjectsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	tflog.Info(ctx, "Reading projects datasource.")
+	response, err := r.client.ListProjects(ctx, data.OrganizationId.ValueString(), nil).All(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, err)
+		return
+	}
+
+	// Data value setting
+	projects := []datasource_projects.ProjectModel{}
+	for _, project := range response {
+		if !data.Tag.IsNull() && !slices.Contains(project.Tags, data.Tag.ValueString()) {
+			continue
+		}
+		if !data.Status.IsNull() && data.Status.ValueString() != project.Status {
+			continue
+		}
+
+		tags, diags := types.ListValueFrom(ctx, types.StringType, project.Tags)
+		resp.Diagnostics.Append(diags...)
+		projects = append(projects, datasource_projects.ProjectModel{
+			Id:          types.StringValue(project.ID),
+			Name:        types.StringValue(project.Name),
+			Description: types.StringValue(project.Description),
+			Tags:        tags,
+			Status:      types.StringValue(project.Status),
+			CreatedAt:   types.StringValue(project.CreatedAt),
+			UpdatedAt:   types.StringValue(project.UpdatedAt),
+		})
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Name.ValueString() < projects[j].Name.ValueString()
+	})
+
+	projectList, diags := types.ListValueFrom(ctx, datasource_projects.ProjectType, projects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Projects = projectList
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,13 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
 	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/keycloak"
@@ -25,16 +34,31 @@ func New() func() provider.Provider {
 type sys11IamProvider struct{}
 
 type sys11IamProviderModel struct {
-	OidcUrl              types.String `tfsdk:"oidc_url"`
-	IamUrl               types.String `tfsdk:"iam_url"`
-	OidcClientUsername   types.String `tfsdk:"oidc_client_username"`
-	OidcClientPassword   types.String `tfsdk:"oidc_client_password"`
-	OidcClientSecret     types.String `tfsdk:"oidc_client_secret"`
-	OidcClientId         types.String `tfsdk:"oidc_client_id"`
-	OidcClientScope      types.String `tfsdk:"oidc_client_scope"`
-	ServiceAccountSecret types.String `tfsdk:"serviceaccount_secret"`
+	OidcUrl               types.String  `tfsdk:"oidc_url"`
+	OidcIssuerUrl         types.String  `tfsdk:"oidc_issuer_url"`
+	IamUrl                types.String  `tfsdk:"iam_url"`
+	OidcClientUsername    types.String  `tfsdk:"oidc_client_username"`
+	OidcClientPassword    types.String  `tfsdk:"oidc_client_password"`
+	OidcClientSecret      types.String  `tfsdk:"oidc_client_secret"`
+	OidcClientId          types.String  `tfsdk:"oidc_client_id"`
+	OidcClientScope       types.String  `tfsdk:"oidc_client_scope"`
+	OidcGrantType         types.String  `tfsdk:"oidc_grant_type"`
+	ServiceAccountSecret  types.String  `tfsdk:"serviceaccount_secret"`
+	RetryMaxAttempts      types.Int64   `tfsdk:"retry_max_attempts"`
+	RetryBaseDelay        types.String  `tfsdk:"retry_base_delay"`
+	RetryMaxDelay         types.String  `tfsdk:"retry_max_delay"`
+	RateLimit             types.Float64 `tfsdk:"rate_limit"`
+	RateLimitBurst        types.Int64   `tfsdk:"rate_limit_burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
+// defaults for the optional client settings
+const defaultRetryMaxAttempts int64 = 3
+const defaultRetryBaseDelay string = "1s"
+const defaultRetryMaxDelay string = "30s"
+const defaultRateLimit float64 = 0
+const defaultMaxConcurrentRequests int64 = 0
+
 func (p *sys11IamProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -42,6 +66,9 @@ func (p *sys11IamProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:  true,
 				Sensitive: true,
 			},
+			"oidc_issuer_url": schema.StringAttribute{
+				Optional: true,
+			},
 			"iam_url": schema.StringAttribute{
 				Optional: true,
 			},
@@ -60,9 +87,57 @@ func (p *sys11IamProvider) Schema(ctx context.Context, req provider.SchemaReques
 			"oidc_client_scope": schema.StringAttribute{
 				Optional: true,
 			},
+			"oidc_grant_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(keycloak.PasswordGrant, keycloak.ClientCredentialsGrant),
+				},
+			},
 			"serviceaccount_secret": schema.StringAttribute{
 				Optional: true,
 			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "How often a request to the IAM API is attempted in total before giving up.",
+				MarkdownDescription: "How often a request to the IAM API is attempted in total before giving up.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_base_delay": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The delay before the first retry, doubled for every further retry.",
+				MarkdownDescription: "The delay before the first retry, doubled for every further retry.",
+			},
+			"retry_max_delay": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The longest delay between two retries.",
+				MarkdownDescription: "The longest delay between two retries.",
+			},
+			"rate_limit": schema.Float64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of requests per second sent to the IAM API, e.g. 0.5 for one request every two seconds. 0 disables the limit.",
+				MarkdownDescription: "The maximum number of requests per second sent to the IAM API, e.g. 0.5 for one request every two seconds. 0 disables the limit.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of requests that may exceed the rate limit in a short burst, defaults to the rate limit. Requires rate_limit.",
+				MarkdownDescription: "The number of requests that may exceed the rate limit in a short burst, defaults to the rate limit. Requires `rate_limit`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of requests in flight to the IAM API at the same time, 0 disables the limit.",
+				MarkdownDescription: "The maximum number of requests in flight to the IAM API at the same time, 0 disables the limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -88,6 +163,24 @@ func (p *sys11IamProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.OidcIssuerUrl.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc_issuer_url"),
+			"Unknown NCS OIDC issuer Url.",
+			"The provider cannot create the OIDC API client as there is an unknown configuration value for the OIDC issuer url. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SYS11IAM_OIDC_ISSUER_URL environment variable.",
+		)
+	}
+
+	if config.OidcGrantType.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc_grant_type"),
+			"Unknown NCS OIDC grant type.",
+			"The provider cannot create the OIDC API client as there is an unknown configuration value for the OIDC grant type. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SYS11IAM_OIDC_GRANT_TYPE environment variable.",
+		)
+	}
+
 	if config.ServiceAccountSecret.IsUnknown() {
 		if !(config.OidcUrl.IsUnknown() || config.OidcClientSecret.IsUnknown() || config.OidcClientUsername.IsUnknown() || config.OidcClientPassword.IsUnknown() || config.OidcClientId.IsUnknown() || config.OidcClientScope.IsUnknown()) {
 			if config.OidcUrl.IsUnknown() {
@@ -133,18 +226,24 @@ func (p *sys11IamProvider) Configure(ctx context.Context, req provider.Configure
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 	oidcUrl := os.Getenv("SYS11IAM_OIDC_URL")
+	oidcIssuerUrl := os.Getenv("SYS11IAM_OIDC_ISSUER_URL")
 	iamUrl := os.Getenv("SYS11IAM_IAM_URL")
 	oidcClientUsername := os.Getenv("SYS11IAM_OIDC_CLIENT_USERNAME")
 	oidcClientPassword := os.Getenv("SYS11IAM_OIDC_CLIENT_PASSWORD")
 	oidcClientSecret := os.Getenv("SYS11IAM_OIDC_CLIENT_SECRET")
 	oidcClientId := os.Getenv("SYS11IAM_OIDC_CLIENT_ID")
 	oidcClientScope := os.Getenv("SYS11IAM_OIDC_CLIENT_SCOPE")
+	oidcGrantType := os.Getenv("SYS11IAM_OIDC_GRANT_TYPE")
 	serviceAccountSecret := os.Getenv("SYS11IAM_SERVICEACCOUNT_SECRET")
 
 	if !config.OidcUrl.IsNull() {
 		oidcUrl = config.OidcUrl.ValueString()
 	}
 
+	if !config.OidcIssuerUrl.IsNull() {
+		oidcIssuerUrl = config.OidcIssuerUrl.ValueString()
+	}
+
 	if !config.OidcClientUsername.IsNull() {
 		oidcClientUsername = config.OidcClientUsername.ValueString()
 	}
@@ -165,6 +264,14 @@ func (p *sys11IamProvider) Configure(ctx context.Context, req provider.Configure
 		oidcClientScope = config.OidcClientScope.ValueString()
 	}
 
+	if !config.OidcGrantType.IsNull() {
+		oidcGrantType = config.OidcGrantType.ValueString()
+	}
+
+	if oidcGrantType == "" {
+		oidcGrantType = keycloak.PasswordGrant
+	}
+
 	if !config.IamUrl.IsNull() {
 		iamUrl = config.IamUrl.ValueString()
 	}
@@ -186,40 +293,23 @@ func (p *sys11IamProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	if serviceAccountSecret == "" {
-		if !(oidcUrl == "" || oidcClientSecret == "" || oidcClientUsername == "" || oidcClientPassword == "" || oidcClientId == "" || oidcClientScope == "") {
-			if oidcUrl == "" {
-				resp.Diagnostics.AddAttributeError(
-					path.Root("oidc_url"),
-					"Unknown NCS OIDC API Url",
-					"The provider cannot create the OIDC API client as there is an unknown configuration value for the OIDC API url. "+
-						"Either target apply the source of the value first, set the value statically in the configuration, or use the SYS11IAM_OIDC_URL environment variable.",
-				)
-			}
-
-			if oidcClientSecret == "" && oidcClientUsername == "" || oidcClientPassword == "" || oidcClientId == "" || oidcClientScope == "" {
-				resp.Diagnostics.AddAttributeError(
-					path.Root("oidc_client"),
-					"Unknown NCS OIDC API client credentials. Provide username+password+id+secret combination (regular account).",
-					"The provider cannot create the OIDC API client as there is an unknown configuration value for the OIDC API client. "+
-						"Set the client secret value in the configuration or use the SYS11IAM_OIDC_CLIENT_SECRET environment variable. "+
-						"If either is already set, ensure the value is not empty."+
-						"Set the client username value in the configuration or use the SYS11IAM_OIDC_CLIENT_USERNAME environment variable. "+
-						"If either is already set, ensure the value is not empty."+
-						"Set the client password value in the configuration or use the SYS11IAM_OIDC_CLIENT_PASSWORD environment variable. "+
-						"If either is already set, ensure the value is not empty."+
-						"Set the client id value in the configuration or use the SYS11IAM_OIDC_CLIENT_ID environment variable. "+
-						"If either is already set, ensure the value is not empty."+
-						"Set the client scope value in the configuration or use the SYS11IAM_OIDC_CLIENT_SCOPE environment variable. "+
-						"If either is already set, ensure the value is not empty.",
-				)
-			}
-		} else {
+		if oidcUrl == "" && oidcIssuerUrl == "" && oidcClientId == "" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("serviceaccount_secret"),
 				"Unknown NCS service account secret. Alternatively provide regular account authentication details as described below.",
 				"Set the client secret value in the configuration or use the SYS11IAM_SERVICEACCOUNT_SECRET environment variable. "+
 					"If either is already set, ensure the value is not empty.",
 			)
+		} else {
+			validateOidcConfig(resp, oidcGrantType, map[string]string{
+				"oidc_url":             oidcUrl,
+				"oidc_issuer_url":      oidcIssuerUrl,
+				"oidc_client_id":       oidcClientId,
+				"oidc_client_secret":   oidcClientSecret,
+				"oidc_client_scope":    oidcClientScope,
+				"oidc_client_username": oidcClientUsername,
+				"oidc_client_password": oidcClientPassword,
+			})
 		}
 	}
 
@@ -227,19 +317,67 @@ func (p *sys11IamProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Create a new NCS Keystone client using the configuration values
-	client := iam.NewClient(iamUrl, 10)
+	retryMaxAttempts := int64Setting(resp, config.RetryMaxAttempts, "retry_max_attempts", defaultRetryMaxAttempts)
+	retryBaseDelay := durationSetting(resp, config.RetryBaseDelay, "retry_base_delay", defaultRetryBaseDelay)
+	retryMaxDelay := durationSetting(resp, config.RetryMaxDelay, "retry_max_delay", defaultRetryMaxDelay)
+
+	rateLimit := float64Setting(resp, config.RateLimit, "rate_limit", defaultRateLimit)
+	rateLimitBurst := int64Setting(resp, config.RateLimitBurst, "rate_limit_burst", int64(math.Ceil(rateLimit)))
+	maxConcurrentRequests := int64Setting(resp, config.MaxConcurrentRequests, "max_concurrent_requests", defaultMaxConcurrentRequests)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if rateLimit == 0 && (!config.RateLimitBurst.IsNull() || os.Getenv(environmentVariable("rate_limit_burst")) != "") {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("rate_limit_burst"),
+			"Ignored value for rate_limit_burst.",
+			"The rate_limit_burst only applies together with a rate_limit. Set rate_limit or the SYS11IAM_RATE_LIMIT environment variable to limit the requests.",
+		)
+	}
+
+	if retryMaxDelay < retryBaseDelay {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_delay"),
+			"Invalid value for retry_max_delay.",
+			fmt.Sprintf("The retry_max_delay of %s must not be shorter than the retry_base_delay of %s.", retryMaxDelay, retryBaseDelay),
+		)
+		return
+	}
+
+	// Create a new NCS Keystone client using the configuration values, the
+	// client and thereby its limits are shared by all resources
+	client := iam.NewClient(iamUrl, 10).
+		WithRetry(int(retryMaxAttempts), retryBaseDelay, retryMaxDelay).
+		WithRateLimit(rateLimit, int(rateLimitBurst)).
+		WithMaxConcurrentRequests(int(maxConcurrentRequests))
 	if oidcClientId != "" {
-		keycloakClient := keycloak.NewClient(oidcUrl, 10).
-			WithClientConfig(oidcClientId, oidcClientSecret, oidcClientScope, oidcClientUsername, oidcClientPassword)
-		token, err := keycloakClient.Login()
+		keycloakClient := keycloak.NewClient(oidcUrl, 10)
+		if oidcIssuerUrl != "" {
+			keycloakClient = keycloak.NewClient(oidcIssuerUrl, 10)
+			_, err := keycloakClient.Discover(ctx)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("oidc_issuer_url"), "OIDC discovery error", err.Error())
+				return
+			}
+		}
+		if oidcGrantType == keycloak.ClientCredentialsGrant {
+			keycloakClient.WithClientCredentials(oidcClientId, oidcClientSecret, oidcClientScope)
+		} else {
+			keycloakClient.WithClientConfig(oidcClientId, oidcClientSecret, oidcClientScope, oidcClientUsername, oidcClientPassword)
+		}
+		// Log in once up front so that credential errors are reported during
+		// configuration, later requests renew the token as needed
+		tokenSource := keycloak.NewTokenSource(keycloakClient)
+		_, err := tokenSource.Token(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError("Login error", err.Error())
 			return
 		}
 
 		// Create a new NCS IAM client using the configuration values
-		client.WithBearerToken(token)
+		client.WithTokenSource(tokenSource)
 	} else {
 		client.WithServiceAccountToken(serviceAccountSecret)
 	}
@@ -249,6 +387,179 @@ func (p *sys11IamProvider) Configure(ctx context.Context, req provider.Configure
 	resp.ResourceData = client
 }
 
+// oidcRequiredSettings lists the settings each grant type needs, the password
+// grant authenticates a user on behalf of the client, the client_credentials
+// grant authenticates the client itself
+var oidcRequiredSettings = map[string][]string{
+	keycloak.PasswordGrant:          {"oidc_client_id", "oidc_client_secret", "oidc_client_scope", "oidc_client_username", "oidc_client_password"},
+	keycloak.ClientCredentialsGrant: {"oidc_client_id", "oidc_client_secret"},
+}
+
+// validateOidcConfig reports every setting that is missing for the grant type,
+// as well as user credentials that would be ignored by the client_credentials grant
+func validateOidcConfig(resp *provider.ConfigureResponse, grantType string, settings map[string]string) {
+	required, ok := oidcRequiredSettings[grantType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc_grant_type"),
+			"Invalid NCS OIDC grant type.",
+			fmt.Sprintf("The OIDC grant type must be one of %q or %q, got: %q. "+
+				"Set the grant type in the configuration or use the SYS11IAM_OIDC_GRANT_TYPE environment variable.",
+				keycloak.PasswordGrant, keycloak.ClientCredentialsGrant, grantType),
+		)
+		return
+	}
+
+	if settings["oidc_url"] == "" && settings["oidc_issuer_url"] == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc_url"),
+			"Unknown NCS OIDC API Url",
+			"The provider cannot create the OIDC API client as neither a token endpoint nor an issuer url is configured. "+
+				"Set oidc_url to the token endpoint or oidc_issuer_url to the issuer, either in the configuration "+
+				"or by using the SYS11IAM_OIDC_URL or SYS11IAM_OIDC_ISSUER_URL environment variable.",
+		)
+	}
+	if settings["oidc_url"] != "" && settings["oidc_issuer_url"] != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc_issuer_url"),
+			"Conflicting NCS OIDC API Urls",
+			"Only one of oidc_url and oidc_issuer_url may be set. Set oidc_issuer_url to discover the token endpoint from the issuer, "+
+				"or oidc_url to use a token endpoint directly.",
+		)
+	}
+
+	for _, attribute := range required {
+		if settings[attribute] != "" {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Missing NCS OIDC setting %s for the %s grant.", attribute, grantType),
+			fmt.Sprintf("The provider cannot create the OIDC API client as the %s grant requires a value for %s. "+
+				"Set the value in the configuration or use the %s environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+				grantType, attribute, environmentVariable(attribute)),
+		)
+	}
+
+	if grantType == keycloak.ClientCredentialsGrant {
+		for _, attribute := range []string{"oidc_client_username", "oidc_client_password"} {
+			if settings[attribute] == "" {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				fmt.Sprintf("Unexpected NCS OIDC setting %s for the %s grant.", attribute, grantType),
+				fmt.Sprintf("The %s grant authenticates the client itself and does not use user credentials. "+
+					"Either remove %s from the configuration and the %s environment variable, or set oidc_grant_type to %q.",
+					grantType, attribute, environmentVariable(attribute), keycloak.PasswordGrant),
+			)
+		}
+	}
+}
+
+// environmentVariable returns the environment variable that backs a provider attribute
+func environmentVariable(attribute string) string {
+	return "SYS11IAM_" + strings.ToUpper(attribute)
+}
+
+// int64Setting resolves a numeric attribute from the configuration, its
+// environment variable or the default, in that order
+func int64Setting(resp *provider.ConfigureResponse, value types.Int64, attribute string, defaultValue int64) int64 {
+	if value.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Unknown value for %s.", attribute),
+			fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.",
+				environmentVariable(attribute)),
+		)
+		return defaultValue
+	}
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+
+	env := os.Getenv(environmentVariable(attribute))
+	if env == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Invalid value for %s.", attribute),
+			fmt.Sprintf("The %s environment variable must be a number: %s", environmentVariable(attribute), err.Error()),
+		)
+		return defaultValue
+	}
+	return parsed
+}
+
+// float64Setting resolves a fractional attribute from the configuration, its
+// environment variable or the default, in that order
+func float64Setting(resp *provider.ConfigureResponse, value types.Float64, attribute string, defaultValue float64) float64 {
+	if value.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Unknown value for %s.", attribute),
+			fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.",
+				environmentVariable(attribute)),
+		)
+		return defaultValue
+	}
+	if !value.IsNull() {
+		return value.ValueFloat64()
+	}
+
+	env := os.Getenv(environmentVariable(attribute))
+	if env == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(env, 64)
+	if err != nil || parsed < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Invalid value for %s.", attribute),
+			fmt.Sprintf("The %s environment variable must be a positive number, got: %q", environmentVariable(attribute), env),
+		)
+		return defaultValue
+	}
+	return parsed
+}
+
+// durationSetting resolves a duration attribute such as "500ms" or "1m" from
+// the configuration, its environment variable or the default, in that order
+func durationSetting(resp *provider.ConfigureResponse, value types.String, attribute string, defaultValue string) time.Duration {
+	if value.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Unknown value for %s.", attribute),
+			fmt.Sprintf("Either target apply the source of the value first, set the value statically in the configuration, or use the %s environment variable.",
+				environmentVariable(attribute)),
+		)
+		return 0
+	}
+
+	setting := defaultValue
+	if env := os.Getenv(environmentVariable(attribute)); env != "" {
+		setting = env
+	}
+	if !value.IsNull() {
+		setting = value.ValueString()
+	}
+
+	parsed, err := time.ParseDuration(setting)
+	if err != nil || parsed < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			fmt.Sprintf("Invalid value for %s.", attribute),
+			fmt.Sprintf("The value must be a positive duration such as \"500ms\" or \"1m\", got: %q", setting),
+		)
+		return 0
+	}
+	return parsed
+}
+
 func (p *sys11IamProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "sys11iam"
 }
@@ -256,6 +567,18 @@ func (p *sys11IamProvider) Metadata(ctx context.Context, req provider.MetadataRe
 func (p *sys11IamProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewOrganizationDataSource,
+		NewOrganizationsDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewOrganizationMembersDataSource,
+		NewProjectMembersDataSource,
+		NewOrganizationTeamsDataSource,
+		NewOrganizationTeamMembersDataSource,
+		NewPermissionsDataSource,
+		NewCurrentIdentityDataSource,
+		NewOrganizationInvitationsDataSource,
+		NewProjectS3usersDataSource,
+		NewOrganizationContactsDataSource,
 	}
 }
 
@@ -264,6 +587,7 @@ func (p *sys11IamProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewOrganizationResource, NewProjectResource, NewOrganizationMembershipResource, NewProjectMembershipResource,
 		NewOrganizationServiceaccountResource, NewOrganizationContactResource, NewOrganizationTeamResource,
 		NewOrganizationTeamMembershipResource, NewProjectTeamMembershipResource, NewProjectS3UserResource,
-		NewProjectTeamResource, NewProjectS3UserKeyResource,
+		NewProjectTeamResource, NewProjectS3UserKeyResource, NewOrganizationServiceaccountSecretResource,
+		NewOrganizationInvitationResource,
 	}
 }
@@ -0,0 +1,1221 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/suite"
+	"github.com/syseleven/terraform-provider-sys11iam/internal/clients/iam"
+	responses "github.com/syseleven/terraform-provider-sys11iam/internal/http-responses"
+)
+
+type ResourceTestSuite struct {
+	suite.Suite
+}
+
+var notFoundResponse = []byte(`{"detail": "not found"}`)
+
+var exampleOrganization = iam.IAMOrganization{ID: "1", Name: "sample-org", Description: "sample-org", Tags: []string{"sample-tag"}, CreatedAt: "date", IsActive: true, UpdatedAt: "date"}
+var exampleProject = iam.IAMProject{ID: "2", Name: "sample-project", Description: "sample-project", Tags: []string{"sample-tag"}, CreatedAt: "date", UpdatedAt: "date", Status: "active"}
+var exampleUser = iam.IAMOrganisationUser{ID: "1", Email: "test@syseleven.net"}
+
+// newState returns a state of the resource with the given top level attributes set
+func (suite *ResourceTestSuite) newState(r resource.Resource, attributes map[string]interface{}) tfsdk.State {
+	ctx := context.Background()
+	schemaResponse := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	suite.False(schemaResponse.Diagnostics.HasError(), schemaResponse.Diagnostics)
+
+	state := tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    nullObject(schemaResponse.Schema.Type().TerraformType(ctx)),
+	}
+	for name, value := range attributes {
+		diags := state.SetAttribute(ctx, path.Root(name), value)
+		suite.False(diags.HasError(), diags)
+	}
+	return state
+}
+
+// configure configures the resource with a client for the mock server
+func (suite *ResourceTestSuite) configure(mockServer *responses.MockServer, r resource.Resource) context.Context {
+	ctx := context.Background()
+	client := iam.NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+
+	configureResponse := resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &configureResponse)
+	suite.False(configureResponse.Diagnostics.HasError(), configureResponse.Diagnostics)
+	return ctx
+}
+
+// read configures the resource with a client for the mock server and runs Read
+// on the given state
+func (suite *ResourceTestSuite) read(mockServer *responses.MockServer, r resource.Resource, state tfsdk.State) resource.ReadResponse {
+	ctx := suite.configure(mockServer, r)
+	readResponse := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResponse)
+	return readResponse
+}
+
+// create configures the resource with a client for the mock server and runs
+// Create on a plan with the given top level attributes set
+func (suite *ResourceTestSuite) create(mockServer *responses.MockServer, r resource.Resource, attributes map[string]interface{}) resource.CreateResponse {
+	ctx := suite.configure(mockServer, r)
+	state := suite.newState(r, attributes)
+	createResponse := resource.CreateResponse{State: tfsdk.State{Schema: state.Schema, Raw: nullObject(state.Schema.Type().TerraformType(ctx))}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}, &createResponse)
+	return createResponse
+}
+
+// update configures the resource with a client for the mock server and runs
+// Update from the given state to a plan with the given top level attributes set
+func (suite *ResourceTestSuite) update(mockServer *responses.MockServer, r resource.Resource, state tfsdk.State, attributes map[string]interface{}) resource.UpdateResponse {
+	ctx := suite.configure(mockServer, r)
+	planned := suite.newState(r, attributes)
+	plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+	updateResponse := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, &updateResponse)
+	return updateResponse
+}
+
+// delete configures the resource with a client for the mock server and runs
+// Delete on the given state
+func (suite *ResourceTestSuite) delete(mockServer *responses.MockServer, r resource.Resource, state tfsdk.State) resource.DeleteResponse {
+	ctx := suite.configure(mockServer, r)
+	deleteResponse := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResponse)
+	return deleteResponse
+}
+
+// modifyPlan configures the resource with a client for the mock server and runs
+// ModifyPlan on a plan with the given top level attributes set
+func (suite *ResourceTestSuite) modifyPlan(mockServer *responses.MockServer, r resource.Resource, attributes map[string]interface{}) resource.ModifyPlanResponse {
+	ctx := suite.configure(mockServer, r)
+	state := suite.newState(r, attributes)
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	modifyPlanResponse := resource.ModifyPlanResponse{Plan: plan}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &modifyPlanResponse)
+	return modifyPlanResponse
+}
+
+// nullObject returns an object of the given type with all attributes set to null
+func nullObject(objectType tftypes.Type) tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.(tftypes.Object).AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func (suite *ResourceTestSuite) marshal(v interface{}) []byte {
+	body, err := json.Marshal(v)
+	suite.NoError(err)
+	return body
+}
+
+func (suite *ResourceTestSuite) assertRemoved(readResponse resource.ReadResponse) {
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+	suite.True(readResponse.State.Raw.IsNull())
+}
+
+func (suite *ResourceTestSuite) assertAttribute(readResponse resource.ReadResponse, name string, expected interface{}) {
+	suite.False(readResponse.Diagnostics.HasError(), readResponse.Diagnostics)
+	suite.False(readResponse.State.Raw.IsNull())
+
+	actual := reflect.New(reflect.TypeOf(expected))
+	diags := readResponse.State.GetAttribute(context.Background(), path.Root(name), actual.Interface())
+	suite.False(diags.HasError(), diags)
+	suite.Equal(expected, actual.Elem().Interface())
+}
+
+func (suite *ResourceTestSuite) TestOrganizationRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "name": "sample-org", "description": "old"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertAttribute(readResponse, "description", "sample-org")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationReadUpgradesState() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+	)
+	defer mockServer.Close()
+
+	// states written before wait_for_activation was added hold null
+	r := NewOrganizationResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "name": "sample-org"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertAttribute(readResponse, "wait_for_activation", false)
+	suite.assertAttribute(readResponse, "timeouts", nullTimeouts())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationCreateWaitForActivation() {
+	defer func(interval time.Duration) { waitInitialInterval = interval }(waitInitialInterval)
+	waitInitialInterval = time.Millisecond
+
+	inactiveOrganization := exampleOrganization
+	inactiveOrganization.IsActive = false
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("POST", "/v1/orgs").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody(suite.marshal(inactiveOrganization)),
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(inactiveOrganization)),
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"name": "sample-org", "description": "sample-org", "tags": []string{"sample-tag"}, "wait_for_activation": true})
+	suite.False(createResponse.Diagnostics.HasError(), createResponse.Diagnostics)
+	suite.Zero(createResponse.Diagnostics.WarningsCount(), createResponse.Diagnostics)
+
+	var isActive bool
+	createResponse.State.GetAttribute(context.Background(), path.Root("is_active"), &isActive)
+	suite.True(isActive)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationCreateWaitForActivationFails() {
+	inactiveOrganization := exampleOrganization
+	inactiveOrganization.IsActive = false
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("POST", "/v1/orgs").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody(suite.marshal(inactiveOrganization)),
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusForbidden).
+			ReturnWithBody([]byte(`{"detail": "forbidden"}`)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"name": "sample-org", "description": "sample-org", "tags": []string{"sample-tag"}, "wait_for_activation": true})
+
+	// an error would taint the organization, which has to be activated manually
+	suite.False(createResponse.Diagnostics.HasError(), createResponse.Diagnostics)
+	suite.Equal("OrganizationNotActiveWarning", createResponse.Diagnostics.Warnings()[0].Summary())
+
+	var id string
+	var isActive bool
+	createResponse.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	createResponse.State.GetAttribute(context.Background(), path.Root("is_active"), &isActive)
+	suite.Equal("1", id)
+	suite.False(isActive)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "name": "sample-org"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects/2").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMProject{ID: "2", Name: "sample-project", Tags: []string{}})),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectResource()
+	state := suite.newState(r, map[string]interface{}{"id": "2", "organization_id": "1", "name": "old"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "name", "sample-project")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects/2").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectResource()
+	state := suite.newState(r, map[string]interface{}{"id": "2", "organization_id": "1"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectReadError() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/projects/2").
+			ReturnWithCode(http.StatusForbidden).
+			ReturnWithBody([]byte(`{"detail": "forbidden"}`)),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectResource()
+	state := suite.newState(r, map[string]interface{}{"id": "2", "organization_id": "1"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.True(readResponse.Diagnostics.HasError())
+	suite.False(readResponse.State.Raw.IsNull())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationMembership{Organisation: exampleOrganization, User: exampleUser, Affiliation: "member", Permissions: []string{"can_do"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "is_active": true, "affiliation": "old"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "affiliation", "member")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipReadUpgradesState() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationMembership{Organisation: exampleOrganization, User: exampleUser, Affiliation: "member", Permissions: []string{"can_do"}})),
+	)
+	defer mockServer.Close()
+
+	// states written before wait_for_acceptance was added hold null
+	r := NewOrganizationMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "is_active": true, "affiliation": "member"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertAttribute(readResponse, "wait_for_acceptance", false)
+	suite.assertAttribute(readResponse, "timeouts", nullTimeouts())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipCreateNotAccepted() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganizationInvitation{{ID: "1", Email: exampleUser.Email, ExpirationDate: "2999-01-01T00:00:00Z"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationMembershipResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "email": exampleUser.Email, "affiliation": "member", "editable_permissions": []string{}, "wait_for_acceptance": false})
+	suite.True(createResponse.Diagnostics.HasError())
+	suite.Equal("InvitationNotAcceptedError", createResponse.Diagnostics.Errors()[0].Summary())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipCreateWaitForAcceptance() {
+	defer func(interval time.Duration) { waitInitialInterval = interval }(waitInitialInterval)
+	waitInitialInterval = time.Millisecond
+
+	membership := iam.IAMOrganizationMembership{Organisation: exampleOrganization, User: exampleUser, Affiliation: "member", MembershipType: "user", Permissions: []string{"can_do"}}
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganizationInvitation{{ID: "1", Email: exampleUser.Email, ExpirationDate: "2999-01-01T00:00:00Z"}})),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganizationMembership{membership})),
+		responses.Expect("GET", "/v2/orgs/1/memberships/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(membership)),
+		responses.Expect("PATCH", "/v2/orgs/1/memberships/1").
+			WithJSONParameters(map[string]interface{}{"affiliation": "member", "membership_type": "user", "editable_permissions": []string{"can_do"}}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(membership)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationMembershipResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "email": exampleUser.Email, "affiliation": "member", "editable_permissions": []string{"can_do"}, "wait_for_acceptance": true})
+	suite.False(createResponse.Diagnostics.HasError(), createResponse.Diagnostics)
+
+	var id string
+	createResponse.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	suite.Equal("1", id)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestWaitForTimeout() {
+	defer func(interval time.Duration) { waitInitialInterval = interval }(waitInitialInterval)
+	waitInitialInterval = time.Millisecond
+
+	checks := 0
+	err := waitFor(context.Background(), 50*time.Millisecond, func(ctx context.Context) (bool, error) {
+		checks++
+		return false, nil
+	})
+	suite.ErrorIs(err, errWaitTimeout)
+	suite.Greater(checks, 1)
+}
+
+func (suite *ResourceTestSuite) TestWaitForCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := waitFor(ctx, time.Minute, func(ctx context.Context) (bool, error) {
+		cancel()
+		return false, ctx.Err()
+	})
+	suite.ErrorIs(err, context.Canceled)
+	suite.NotErrorIs(err, errWaitTimeout)
+}
+
+func (suite *ResourceTestSuite) TestWaitForDoneAtTimeout() {
+	err := waitFor(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		<-ctx.Done()
+		return true, nil
+	})
+	suite.NoError(err)
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "is_active": true})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipReadPendingInvitation() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	// memberships stored with the placeholder id of earlier versions
+	r := NewOrganizationMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "0", "organization_id": "1", "email": exampleUser.Email, "is_active": false})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertRemoved(readResponse)
+	suite.Equal("PendingInvitationWarning", readResponse.Diagnostics.Warnings()[0].Summary())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationMembershipReadAcceptedInvitation() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganizationMembership{{Organisation: exampleOrganization, User: exampleUser, Affiliation: "member", Permissions: []string{"can_do"}}})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "0", "organization_id": "1", "email": exampleUser.Email, "is_active": false})
+	suite.assertAttribute(suite.read(mockServer, r, state), "id", "1")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationInvitationRead() {
+	for status, invitation := range map[string]iam.IAMOrganizationInvitation{"expired": exampleInvitations[0], "pending": exampleInvitations[1]} {
+		mockServer := responses.NewMockServer(
+			&suite.Suite,
+			responses.Expect("GET", "/v1/orgs/1/invitations").
+				WithQueryParameters(map[string]string{"email": invitation.Email}).
+				ReturnWithCode(http.StatusOK).
+				ReturnWithBody(suite.marshal([]iam.IAMOrganizationInvitation{invitation})),
+		)
+
+		r := NewOrganizationInvitationResource()
+		state := suite.newState(r, map[string]interface{}{"organization_id": "1", "email": invitation.Email, "permissions": []string{"can_do"}, "status": "pending"})
+		readResponse := suite.read(mockServer, r, state)
+		suite.assertAttribute(readResponse, "status", status)
+		suite.assertAttribute(readResponse, "id", invitation.ID)
+		mockServer.HasExpectedRequests()
+		mockServer.Close()
+	}
+}
+
+func (suite *ResourceTestSuite) TestOrganizationInvitationReadAccepted() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMOrganizationMembership{{Organisation: exampleOrganization, User: exampleUser, Affiliation: "member"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationInvitationResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "email": exampleUser.Email, "status": "pending"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "status", "accepted")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationInvitationReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationInvitationResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "email": exampleUser.Email, "status": "pending"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationInvitationReadListNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1/invitations").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	// a 404 of the list endpoint is an error, not a missing invitation
+	r := NewOrganizationInvitationResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "email": exampleUser.Email, "status": "pending"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.True(readResponse.Diagnostics.HasError())
+	suite.False(readResponse.State.Raw.IsNull())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationInvitationModifyPlanResendsExpired() {
+	ctx := context.Background()
+	for resend, status := range map[bool]string{true: "pending", false: "expired"} {
+		r := NewOrganizationInvitationResource()
+		state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "email": exampleUser.Email, "status": "expired", "resend_on_expiry": resend})
+		plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+		modifyPlanResponse := resource.ModifyPlanResponse{Plan: plan}
+		r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &modifyPlanResponse)
+		suite.False(modifyPlanResponse.Diagnostics.HasError(), modifyPlanResponse.Diagnostics)
+
+		var planned string
+		modifyPlanResponse.Plan.GetAttribute(ctx, path.Root("status"), &planned)
+		suite.Equal(status, planned)
+	}
+}
+
+func (suite *ResourceTestSuite) TestProjectMembershipRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/memberships/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMProjectMembership{User: exampleUser, Project: iam.IAMProject{ID: "2"}, Permissions: []string{"can_do"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "project_id": "2"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "email", "test@syseleven.net")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectMembershipCreateNotMember() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("GET", "/v2/orgs/1/memberships").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	// the user is not invited implicitly
+	r := NewProjectMembershipResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "project_id": "2", "email": exampleUser.Email, "permissions": []string{}})
+	suite.True(createResponse.Diagnostics.HasError())
+	suite.Equal("MemberNotFoundError", createResponse.Diagnostics.Errors()[0].Summary())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectMembershipReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/memberships/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "project_id": "2"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccount{ID: "1", Name: "sample-sa", OrganizationId: "1"})),
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/1/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`["can_manage_teams", "can_manage_members"]`)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "name": "old", "permissions": []string{"can_manage_teams"}})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertAttribute(readResponse, "name", "sample-sa")
+	suite.assertAttribute(readResponse, "permissions", []string{"can_manage_members", "can_manage_teams"})
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountReadUnmanagedPermissions() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccount{ID: "1", Name: "sample-sa", OrganizationId: "1"})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "name": "sample-sa"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertAttribute(readResponse, "permissions", types.ListNull(types.StringType))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountCreate() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("POST", "/v2/orgs/1/service-accounts").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccount{ID: "2", Name: "sample-sa", Description: "sample", OrganizationId: "1"})),
+		responses.Expect("POST", "/v2/orgs/1/service-accounts/2/permissions").
+			WithJSONParameters([]string{"can_manage_teams"}).
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody([]byte(`["can_manage_teams"]`)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "name": "sample-sa", "description": "sample", "permissions": []string{"can_manage_teams"}})
+	suite.False(createResponse.Diagnostics.HasError(), createResponse.Diagnostics)
+
+	var permissions []string
+	createResponse.State.GetAttribute(context.Background(), path.Root("permissions"), &permissions)
+	suite.Equal([]string{"can_manage_teams"}, permissions)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountCreateUnmanagedPermissions() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("POST", "/v2/orgs/1/service-accounts").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccount{ID: "2", Name: "sample-sa", Description: "sample", OrganizationId: "1"})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "name": "sample-sa", "description": "sample"})
+	suite.False(createResponse.Diagnostics.HasError(), createResponse.Diagnostics)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountUpdatePermissions() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("PUT", "/v2/orgs/1/service-accounts/2").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccount{ID: "2", Name: "sample-sa", Description: "sample", OrganizationId: "1"})),
+		responses.Expect("PUT", "/v2/orgs/1/service-accounts/2/permissions").
+			WithJSONParameters([]string{}).
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	// an empty list removes all permissions
+	r := NewOrganizationServiceaccountResource()
+	state := suite.newState(r, map[string]interface{}{"id": "2", "organization_id": "1", "name": "sample-sa", "description": "sample", "permissions": []string{"can_manage_teams"}})
+	updateResponse := suite.update(mockServer, r, state, map[string]interface{}{"organization_id": "1", "name": "sample-sa", "description": "sample", "permissions": []string{}})
+	suite.False(updateResponse.Diagnostics.HasError(), updateResponse.Diagnostics)
+
+	var permissions []string
+	updateResponse.State.GetAttribute(context.Background(), path.Root("permissions"), &permissions)
+	suite.Equal([]string{}, permissions)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountReadKeepsPermissionOrder() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccount{ID: "1", Name: "sample-sa", OrganizationId: "1"})),
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/1/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`["can_manage_members", "can_manage_teams"]`)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "permissions": []string{"can_manage_teams", "can_manage_members"}})
+	suite.assertAttribute(suite.read(mockServer, r, state), "permissions", []string{"can_manage_teams", "can_manage_members"})
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountSecretRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/2/secrets/3").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccountSecret{ID: "3", CreatedAt: "date"})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountSecretResource()
+	state := suite.newState(r, map[string]interface{}{"id": "3", "organization_id": "1", "serviceaccount_id": "2", "secret": "s11_orgsa_secret"})
+	readResponse := suite.read(mockServer, r, state)
+	suite.assertAttribute(readResponse, "secret", "s11_orgsa_secret")
+	suite.assertAttribute(readResponse, "created_at", "date")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountSecretReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/service-accounts/2/secrets/3").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountSecretResource()
+	state := suite.newState(r, map[string]interface{}{"id": "3", "organization_id": "1", "serviceaccount_id": "2"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountSecretCreate() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("POST", "/v2/orgs/1/service-accounts/2/secrets").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationServiceaccountSecret{ID: "3", Secret: "s11_orgsa_secret", CreatedAt: "date"})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountSecretResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "serviceaccount_id": "2"})
+	suite.False(createResponse.Diagnostics.HasError(), createResponse.Diagnostics)
+
+	var id, secret types.String
+	createResponse.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	createResponse.State.GetAttribute(context.Background(), path.Root("secret"), &secret)
+	suite.Equal("3", id.ValueString())
+	suite.Equal("s11_orgsa_secret", secret.ValueString())
+	suite.True(createResponse.State.Schema.GetAttributes()["secret"].IsSensitive())
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountSecretCreateDecodeError() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v1/orgs/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(exampleOrganization)),
+		responses.Expect("POST", "/v2/orgs/1/service-accounts/2/secrets").
+			ReturnWithCode(http.StatusCreated).
+			ReturnWithBody([]byte(`{"id": 3, "secret": "s11_orgsa_secret", "created_at": "date", "expires_at": null}`)),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountSecretResource()
+	createResponse := suite.create(mockServer, r, map[string]interface{}{"organization_id": "1", "serviceaccount_id": "2"})
+	suite.True(createResponse.Diagnostics.HasError())
+	for _, diagnostic := range createResponse.Diagnostics {
+		suite.NotContains(diagnostic.Summary(), "s11_orgsa_secret")
+		suite.NotContains(diagnostic.Detail(), "s11_orgsa_secret")
+	}
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationServiceaccountSecretDelete() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("DELETE", "/v2/orgs/1/service-accounts/2/secrets/3").
+			ReturnWithCode(http.StatusNoContent),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationServiceaccountSecretResource()
+	state := suite.newState(r, map[string]interface{}{"id": "3", "organization_id": "1", "serviceaccount_id": "2", "secret": "s11_orgsa_secret"})
+	deleteResponse := suite.delete(mockServer, r, state)
+	suite.False(deleteResponse.Diagnostics.HasError(), deleteResponse.Diagnostics)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationContactRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/contacts/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationContact{ID: "1", Roles: []string{"Technical"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationContactResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "roles", []string{"Technical"})
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationContactReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/contacts/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationContactResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationTeamRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/teams/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationTeam{ID: "1", Name: "sample-team", Tags: []string{"b", "a"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationTeamResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "tags", []string{"a", "b"})
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationTeamReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/teams/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationTeamResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationTeamMembershipRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/teams/2/memberships/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMOrganizationTeamMembership{Organisation: exampleOrganization, User: exampleUser})),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationTeamMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "team_id": "2"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "id", "1")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationTeamMembershipReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/teams/2/memberships/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationTeamMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "team_id": "2"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectTeamRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/teams/3/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`["can_do"]`)),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectTeamResource()
+	state := suite.newState(r, map[string]interface{}{"organization_id": "1", "project_id": "2", "team_id": "3"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "editable_permissions", []string{"can_do"})
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectTeamReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/teams/3/permissions").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectTeamResource()
+	state := suite.newState(r, map[string]interface{}{"organization_id": "1", "project_id": "2", "team_id": "3"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectTeamMembershipRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/teams/3/memberships/1").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMProjectTeamMembership{Permissions: []string{"can_do"}})),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectTeamMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "project_id": "2", "team_id": "3"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "editable_permissions", []string{"can_do"})
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectTeamMembershipReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/teams/3/memberships/1").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectTeamMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "project_id": "2", "team_id": "3"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectS3UserRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/s3-users").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal([]iam.IAMProjectS3User{{ID: "1", Name: "sample-s3user", Keys: []iam.IAMProjectS3UserKey{}}})),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectS3UserResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "project_id": "2"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "id", "1")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectS3UserReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/s3-users").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[]`)),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectS3UserResource()
+	state := suite.newState(r, map[string]interface{}{"id": "1", "organization_id": "1", "project_id": "2"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectS3UserKeyRead() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/s3-users/3/ec2-credentials/key").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(suite.marshal(iam.IAMProjectS3UserKey{AccessKey: "key", SecretKey: "secret"})),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectS3UserKeyResource()
+	state := suite.newState(r, map[string]interface{}{"organization_id": "1", "project_id": "2", "s3_user_id": "3", "s3_access_key": "key"})
+	suite.assertAttribute(suite.read(mockServer, r, state), "secret_key", "secret")
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectS3UserKeyReadNotFound() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/orgs/1/projects/2/s3-users/3/ec2-credentials/key").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectS3UserKeyResource()
+	state := suite.newState(r, map[string]interface{}{"organization_id": "1", "project_id": "2", "s3_user_id": "3", "s3_access_key": "key"})
+	suite.assertRemoved(suite.read(mockServer, r, state))
+	mockServer.HasExpectedRequests()
+}
+
+var examplePermissions = []byte(`[
+	{"name": "can_become_administrator_in_project", "scope": "project", "description": ""},
+	{"name": "can_manage_teams", "scope": "organization", "description": ""}
+]`)
+
+func (suite *ResourceTestSuite) TestProjectTeamModifyPlanInvalidPermission() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(examplePermissions),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectTeamResource()
+	modifyPlanResponse := suite.modifyPlan(mockServer, r, map[string]interface{}{
+		"organization_id":      "1",
+		"project_id":           "2",
+		"team_id":              "3",
+		"editable_permissions": []string{"can_become_administrator_in_project", "can_manage_teams"},
+	})
+	suite.Len(modifyPlanResponse.Diagnostics.Errors(), 1)
+	suite.Contains(modifyPlanResponse.Diagnostics.Errors()[0].Detail(), `"can_manage_teams" is not a valid project permission`)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestOrganizationTeamModifyPlanValidPermission() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody(examplePermissions),
+	)
+	defer mockServer.Close()
+
+	r := NewOrganizationTeamResource()
+	modifyPlanResponse := suite.modifyPlan(mockServer, r, map[string]interface{}{
+		"organization_id":      "1",
+		"name":                 "sample-team",
+		"editable_permissions": []string{"can_manage_teams"},
+	})
+	suite.False(modifyPlanResponse.Diagnostics.HasError(), modifyPlanResponse.Diagnostics)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectMembershipModifyPlanCatalogUnavailable() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	r := NewProjectMembershipResource()
+	modifyPlanResponse := suite.modifyPlan(mockServer, r, map[string]interface{}{
+		"organization_id": "1",
+		"project_id":      "2",
+		"email":           "test@syseleven.net",
+		"permissions":     []string{"typo"},
+	})
+	suite.False(modifyPlanResponse.Diagnostics.HasError(), modifyPlanResponse.Diagnostics)
+	suite.Len(modifyPlanResponse.Diagnostics.Warnings(), 1)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestValidatePermissionsWarnsOnceWhenCatalogUnavailable() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusNotFound).
+			ReturnWithBody(notFoundResponse),
+	)
+	defer mockServer.Close()
+
+	ctx := context.Background()
+	client := iam.NewClient(mockServer.URL, 0).WithBearerToken("testtoken")
+	r := NewProjectMembershipResource()
+	state := suite.newState(r, map[string]interface{}{"organization_id": "1", "project_id": "2", "email": exampleUser.Email, "permissions": []string{"typo"}})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	// the catalog is requested once, and only the first resource gets a warning
+	for _, warnings := range []int{1, 0, 0} {
+		var diagnostics diag.Diagnostics
+		validatePermissions(ctx, client, plan, path.Root("permissions"), iam.ProjectPermissionScope, &diagnostics)
+		suite.False(diagnostics.HasError(), diagnostics)
+		suite.Len(diagnostics.Warnings(), warnings)
+	}
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestProjectMembershipModifyPlanEmptyScope() {
+	mockServer := responses.NewMockServer(
+		&suite.Suite,
+		responses.Expect("GET", "/v2/permissions").
+			ReturnWithCode(http.StatusOK).
+			ReturnWithBody([]byte(`[{"name": "can_do", "scope": "organization", "description": ""}]`)),
+	)
+	defer mockServer.Close()
+
+	// without any project permissions in the catalog nothing is rejected
+	r := NewProjectMembershipResource()
+	modifyPlanResponse := suite.modifyPlan(mockServer, r, map[string]interface{}{
+		"organization_id": "1",
+		"project_id":      "2",
+		"email":           "test@syseleven.net",
+		"permissions":     []string{"will_do"},
+	})
+	suite.False(modifyPlanResponse.Diagnostics.HasError(), modifyPlanResponse.Diagnostics)
+	mockServer.HasExpectedRequests()
+}
+
+func (suite *ResourceTestSuite) TestAddClientError() {
+	ctx := context.Background()
+	apiError := &iam.APIError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Method:     http.MethodPost,
+		URL:        "/v2/orgs",
+		RequestID:  "abc",
+		FieldErrors: []iam.FieldError{
+			{Field: "body.tags.0", Message: "invalid tag"},
+			{Field: "company_info.vat_id", Message: "invalid vat id"},
+			{Field: "company_name", Message: "invalid company name"},
+			{Message: "invalid request"},
+		},
+	}
+	state := suite.newState(NewOrganizationResource(), map[string]interface{}{})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	var diagnostics diag.Diagnostics
+	addPlanClientError(ctx, &diagnostics, plan, apiError)
+	suite.Len(diagnostics, 4)
+	for _, diagnostic := range diagnostics {
+		suite.Equal("IAM API error: 422 Unprocessable Entity", diagnostic.Summary())
+		suite.Contains(diagnostic.Detail(), "request id: abc")
+	}
+	suite.Contains(diagnostics[0].Detail(), "invalid tag")
+	suite.Equal(path.Root("tags").AtListIndex(0), diagnostics[0].(diag.DiagnosticWithPath).Path())
+	suite.Contains(diagnostics[1].Detail(), "invalid vat id")
+	suite.Equal(path.Root("company_info_vat_id"), diagnostics[1].(diag.DiagnosticWithPath).Path())
+	suite.Contains(diagnostics[2].Detail(), "company_name: invalid company name")
+	suite.NotImplements((*diag.DiagnosticWithPath)(nil), diagnostics[2])
+	suite.Contains(diagnostics[3].Detail(), "invalid request")
+	suite.NotImplements((*diag.DiagnosticWithPath)(nil), diagnostics[3])
+
+	diagnostics = nil
+	addClientError(&diagnostics, apiError)
+	suite.Len(diagnostics, 4)
+	for _, diagnostic := range diagnostics {
+		suite.NotImplements((*diag.DiagnosticWithPath)(nil), diagnostic)
+	}
+	suite.Contains(diagnostics[0].Detail(), "body.tags.0: invalid tag")
+
+	diagnostics = nil
+	addClientError(&diagnostics, errors.New("connection refused"))
+	suite.Equal("IAM API error", diagnostics[0].Summary())
+	suite.Equal("connection refused", diagnostics[0].Detail())
+}
+
+func TestResourceTestSuite(t *testing.T) {
+	suite.Run(t, new(ResourceTestSuite))
+}
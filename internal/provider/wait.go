@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// intervals between the checks of waitFor, the interval doubles after every
+// check until it reaches waitMaxInterval
+var (
+	waitInitialInterval = 2 * time.Second
+	waitMaxInterval     = 30 * time.Second
+)
+
+// errWaitTimeout is returned by waitFor when the timeout expired
+var errWaitTimeout = errors.New("timed out")
+
+// nullTimeouts returns an unset timeouts block with a create timeout
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})}
+}
+
+// waitFor runs check with an exponential backoff until it reports done,
+// returns an error or the timeout expires. errWaitTimeout is only returned
+// for the timeout, a cancelled ctx is returned as its own error.
+func waitFor(ctx context.Context, timeout time.Duration, check func(ctx context.Context) (bool, error)) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := waitInitialInterval
+	for {
+		done, err := check(waitCtx)
+		if err == nil && done {
+			return nil
+		}
+		if waitCtx.Err() != nil {
+			// requests that were cancelled by the timeout are not an error of the check
+			return waitError(ctx, waitCtx, timeout)
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return waitError(ctx, waitCtx, timeout)
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, waitMaxInterval)
+	}
+}
+
+// waitError returns errWaitTimeout if waitCtx ended because of the timeout
+// and the error of ctx otherwise
+func waitError(ctx context.Context, waitCtx context.Context, timeout time.Duration) error {
+	if errors.Is(waitCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("%w after %s", errWaitTimeout, timeout)
+	}
+	return ctx.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -148,26 +149,40 @@ func OrganizationResourceSchema(ctx context.Context) schema.Schema {
 					stringvalidator.RegexMatches(regexp.MustCompile("^[ -~]{1,62}$"), ""),
 				},
 			},
+			"wait_for_activation": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Wait until the organization has been activated after creating it.",
+				MarkdownDescription: "Wait until the organization has been activated after creating it.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
 
 type OrganizationModel struct {
-	CreatedAt                         types.String `tfsdk:"created_at"`
-	Description                       types.String `tfsdk:"description"`
-	Id                                types.String `tfsdk:"id"`
-	IsActive                          types.Bool   `tfsdk:"is_active"`
-	Name                              types.String `tfsdk:"name"`
-	Tags                              types.List   `tfsdk:"tags"`
-	UpdatedAt                         types.String `tfsdk:"updated_at"`
-	CompanyInfoStreet                 types.String `tfsdk:"company_info_street"`
-	CompanyInfoStreetNumber           types.String `tfsdk:"company_info_street_number"`
-	CompanyInfoZipCode                types.String `tfsdk:"company_info_zip_code"`
-	CompanyInfoCity                   types.String `tfsdk:"company_info_city"`
-	CompanyInfoCountry                types.String `tfsdk:"company_info_country"`
-	CompanyInfoVatID                  types.String `tfsdk:"company_info_vat_id"`
-	CompanyInfoPreferredBillingMethod types.String `tfsdk:"company_info_preferred_billing_method"`
-	CompanyInfoPhone                  types.String `tfsdk:"company_info_phone"`
-	CompanyInfoAcceptedTos            types.Bool   `tfsdk:"company_info_accepted_tos"`
-	CompanyInfoCompanyName            types.String `tfsdk:"company_info_company_name"`
+	CreatedAt                         types.String   `tfsdk:"created_at"`
+	Description                       types.String   `tfsdk:"description"`
+	Id                                types.String   `tfsdk:"id"`
+	IsActive                          types.Bool     `tfsdk:"is_active"`
+	Name                              types.String   `tfsdk:"name"`
+	Tags                              types.List     `tfsdk:"tags"`
+	UpdatedAt                         types.String   `tfsdk:"updated_at"`
+	CompanyInfoStreet                 types.String   `tfsdk:"company_info_street"`
+	CompanyInfoStreetNumber           types.String   `tfsdk:"company_info_street_number"`
+	CompanyInfoZipCode                types.String   `tfsdk:"company_info_zip_code"`
+	CompanyInfoCity                   types.String   `tfsdk:"company_info_city"`
+	CompanyInfoCountry                types.String   `tfsdk:"company_info_country"`
+	CompanyInfoVatID                  types.String   `tfsdk:"company_info_vat_id"`
+	CompanyInfoPreferredBillingMethod types.String   `tfsdk:"company_info_preferred_billing_method"`
+	CompanyInfoPhone                  types.String   `tfsdk:"company_info_phone"`
+	CompanyInfoAcceptedTos            types.Bool     `tfsdk:"company_info_accepted_tos"`
+	CompanyInfoCompanyName            types.String   `tfsdk:"company_info_company_name"`
+	WaitForActivation                 types.Bool     `tfsdk:"wait_for_activation"`
+	Timeouts                          timeouts.Value `tfsdk:"timeouts"`
 }
@@ -5,6 +5,7 @@ package resource_organization_membership
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -48,15 +49,29 @@ func OrganizationMembershipResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Whether the member is active or not.",
 				PlanModifiers:       []planmodifier.Bool{UseStateForUnknown()},
 			},
+			"wait_for_acceptance": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Wait until the invited user accepted the invitation instead of failing when creating the membership.",
+				MarkdownDescription: "Wait until the invited user accepted the invitation instead of failing when creating the membership.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
 
 type OrganizationMembershipModel struct {
-	Affiliation         types.String `tfsdk:"affiliation"`
-	EditablePermissions types.List   `tfsdk:"editable_permissions"`
-	Email               types.String `tfsdk:"email"`
-	Id                  types.String `tfsdk:"id"`
-	OrganizationId      types.String `tfsdk:"organization_id"`
-	IsActive            types.Bool   `tfsdk:"is_active"`
+	Affiliation         types.String   `tfsdk:"affiliation"`
+	EditablePermissions types.List     `tfsdk:"editable_permissions"`
+	Email               types.String   `tfsdk:"email"`
+	Id                  types.String   `tfsdk:"id"`
+	OrganizationId      types.String   `tfsdk:"organization_id"`
+	IsActive            types.Bool     `tfsdk:"is_active"`
+	WaitForAcceptance   types.Bool     `tfsdk:"wait_for_acceptance"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
@@ -33,6 +33,12 @@ func OrganizationServiceaccountResourceSchema(ctx context.Context) schema.Schema
 			"organization_id": schema.StringAttribute{
 				Required: true,
 			},
+			"permissions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The organization permissions of the service-account",
+				MarkdownDescription: "The organization permissions of the service-account",
+			},
 		},
 	}
 }
@@ -42,4 +48,5 @@ type OrganizationServiceaccountModel struct {
 	Description    types.String `tfsdk:"description"`
 	Id             types.String `tfsdk:"id"`
 	OrganizationId types.String `tfsdk:"organization_id"`
+	Permissions    types.List   `tfsdk:"permissions"`
 }